@@ -11,3 +11,11 @@ final grpc.ClientChannel grpcChannelConfig = grpc.ClientChannel(
     connectTimeout: Duration(seconds: 50),
   ),
 );
+
+/// Metadata header the core requires the session token in.
+const String grpcTokenMetadataKey = 'x-proxy-core-token';
+
+/// Call options sending the session [token] returned by the native
+/// `GRPCSERVER` call with every request.
+grpc.CallOptions grpcCallOptions(String token) =>
+    grpc.CallOptions(metadata: {grpcTokenMetadataKey: token});
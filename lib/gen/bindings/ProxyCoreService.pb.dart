@@ -15,8 +15,12 @@ import 'dart:core' as $core;
 import 'package:fixnum/fixnum.dart' as $fixnum;
 import 'package:protobuf/protobuf.dart' as $pb;
 
+import 'ProxyCoreService.pbenum.dart';
+
 export 'package:protobuf/protobuf.dart' show GeneratedMessageGenericExtensions;
 
+export 'ProxyCoreService.pbenum.dart';
+
 class StartCoreRequest extends $pb.GeneratedMessage {
   factory StartCoreRequest({
     $core.String? coreName,
@@ -27,6 +31,11 @@ class StartCoreRequest extends $pb.GeneratedMessage {
     $core.bool? isVpnMode,
     $core.int? tunFD,
     $core.int? proxyPort,
+    PoolOptions? pool,
+    $core.int? httpPort,
+    $core.bool? mixed,
+    ProxyAuth? auth,
+    TunOptions? tun,
   }) {
     final result = create();
     if (coreName != null) result.coreName = coreName;
@@ -37,6 +46,11 @@ class StartCoreRequest extends $pb.GeneratedMessage {
     if (isVpnMode != null) result.isVpnMode = isVpnMode;
     if (tunFD != null) result.tunFD = tunFD;
     if (proxyPort != null) result.proxyPort = proxyPort;
+    if (pool != null) result.pool = pool;
+    if (httpPort != null) result.httpPort = httpPort;
+    if (mixed != null) result.mixed = mixed;
+    if (auth != null) result.auth = auth;
+    if (tun != null) result.tun = tun;
     return result;
   }
 
@@ -54,6 +68,11 @@ class StartCoreRequest extends $pb.GeneratedMessage {
     ..aOB(6, _omitFieldNames ? '' : 'isVpnMode', protoName: 'isVpnMode')
     ..a<$core.int>(7, _omitFieldNames ? '' : 'tunFD', $pb.PbFieldType.OU3, protoName: 'tunFD')
     ..a<$core.int>(8, _omitFieldNames ? '' : 'proxyPort', $pb.PbFieldType.O3, protoName: 'proxyPort')
+    ..aOM<PoolOptions>(9, _omitFieldNames ? '' : 'pool', subBuilder: PoolOptions.create)
+    ..a<$core.int>(10, _omitFieldNames ? '' : 'httpPort', $pb.PbFieldType.O3, protoName: 'httpPort')
+    ..aOB(11, _omitFieldNames ? '' : 'mixed')
+    ..aOM<ProxyAuth>(12, _omitFieldNames ? '' : 'auth', subBuilder: ProxyAuth.create)
+    ..aOM<TunOptions>(13, _omitFieldNames ? '' : 'tun', subBuilder: TunOptions.create)
     ..hasRequiredFields = false
   ;
 
@@ -145,282 +164,2544 @@ class StartCoreRequest extends $pb.GeneratedMessage {
   $core.bool hasProxyPort() => $_has(7);
   @$pb.TagNumber(8)
   void clearProxyPort() => $_clearField(8);
+
+  /// When set, config is ignored and the core balances over pool.servers
+  @$pb.TagNumber(9)
+  PoolOptions get pool => $_getN(8);
+  @$pb.TagNumber(9)
+  set pool(PoolOptions value) => $_setField(9, value);
+  @$pb.TagNumber(9)
+  $core.bool hasPool() => $_has(8);
+  @$pb.TagNumber(9)
+  void clearPool() => $_clearField(9);
+  @$pb.TagNumber(9)
+  PoolOptions ensurePool() => $_ensure(8);
+
+  /// Extra HTTP proxy port, 0 for none (outline)
+  @$pb.TagNumber(10)
+  $core.int get httpPort => $_getIZ(9);
+  @$pb.TagNumber(10)
+  set httpPort($core.int value) => $_setSignedInt32(9, value);
+  @$pb.TagNumber(10)
+  $core.bool hasHttpPort() => $_has(9);
+  @$pb.TagNumber(10)
+  void clearHttpPort() => $_clearField(10);
+
+  /// proxyPort also takes HTTP proxy clients (outline)
+  @$pb.TagNumber(11)
+  $core.bool get mixed => $_getBF(10);
+  @$pb.TagNumber(11)
+  set mixed($core.bool value) => $_setBool(10, value);
+  @$pb.TagNumber(11)
+  $core.bool hasMixed() => $_has(10);
+  @$pb.TagNumber(11)
+  void clearMixed() => $_clearField(11);
+
+  /// Credentials the local inbounds require
+  @$pb.TagNumber(12)
+  ProxyAuth get auth => $_getN(11);
+  @$pb.TagNumber(12)
+  set auth(ProxyAuth value) => $_setField(12, value);
+  @$pb.TagNumber(12)
+  $core.bool hasAuth() => $_has(11);
+  @$pb.TagNumber(12)
+  void clearAuth() => $_clearField(12);
+  @$pb.TagNumber(12)
+  ProxyAuth ensureAuth() => $_ensure(11);
+
+  /// tun2socks tuning in VPN mode, defaults when unset
+  @$pb.TagNumber(13)
+  TunOptions get tun => $_getN(12);
+  @$pb.TagNumber(13)
+  set tun(TunOptions value) => $_setField(13, value);
+  @$pb.TagNumber(13)
+  $core.bool hasTun() => $_has(12);
+  @$pb.TagNumber(13)
+  void clearTun() => $_clearField(13);
+  @$pb.TagNumber(13)
+  TunOptions ensureTun() => $_ensure(12);
 }
 
-class MeasurePingRequest extends $pb.GeneratedMessage {
-  factory MeasurePingRequest({
-    $core.Iterable<$core.String>? url,
+class TunOptions extends $pb.GeneratedMessage {
+  factory TunOptions({
+    $core.int? mtu,
+    $core.int? udpTimeoutSec,
+    $core.int? tcpSendBufferBytes,
+    $core.int? tcpReceiveBufferBytes,
+    $core.bool? tcpModerateReceiveBuffer,
+    $core.String? logLevel,
+    $core.String? restApi,
+    $core.Iterable<$core.String>? multicastGroups,
+    TunDnsOptions? dns,
+    TunBypassOptions? bypass,
   }) {
     final result = create();
-    if (url != null) result.url.addAll(url);
+    if (mtu != null) result.mtu = mtu;
+    if (udpTimeoutSec != null) result.udpTimeoutSec = udpTimeoutSec;
+    if (tcpSendBufferBytes != null) result.tcpSendBufferBytes = tcpSendBufferBytes;
+    if (tcpReceiveBufferBytes != null) result.tcpReceiveBufferBytes = tcpReceiveBufferBytes;
+    if (tcpModerateReceiveBuffer != null) result.tcpModerateReceiveBuffer = tcpModerateReceiveBuffer;
+    if (logLevel != null) result.logLevel = logLevel;
+    if (restApi != null) result.restApi = restApi;
+    if (multicastGroups != null) result.multicastGroups.addAll(multicastGroups);
+    if (dns != null) result.dns = dns;
+    if (bypass != null) result.bypass = bypass;
     return result;
   }
 
-  MeasurePingRequest._();
+  TunOptions._();
+
+  factory TunOptions.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory TunOptions.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'TunOptions', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..a<$core.int>(1, _omitFieldNames ? '' : 'mtu', $pb.PbFieldType.O3)
+    ..a<$core.int>(2, _omitFieldNames ? '' : 'udpTimeoutSec', $pb.PbFieldType.O3, protoName: 'udpTimeoutSec')
+    ..a<$core.int>(3, _omitFieldNames ? '' : 'tcpSendBufferBytes', $pb.PbFieldType.O3, protoName: 'tcpSendBufferBytes')
+    ..a<$core.int>(4, _omitFieldNames ? '' : 'tcpReceiveBufferBytes', $pb.PbFieldType.O3, protoName: 'tcpReceiveBufferBytes')
+    ..aOB(5, _omitFieldNames ? '' : 'tcpModerateReceiveBuffer', protoName: 'tcpModerateReceiveBuffer')
+    ..aOS(6, _omitFieldNames ? '' : 'logLevel', protoName: 'logLevel')
+    ..aOS(7, _omitFieldNames ? '' : 'restApi', protoName: 'restApi')
+    ..pPS(8, _omitFieldNames ? '' : 'multicastGroups', protoName: 'multicastGroups')
+    ..aOM<TunDnsOptions>(9, _omitFieldNames ? '' : 'dns', subBuilder: TunDnsOptions.create)
+    ..aOM<TunBypassOptions>(10, _omitFieldNames ? '' : 'bypass', subBuilder: TunBypassOptions.create)
+    ..hasRequiredFields = false
+  ;
 
-  factory MeasurePingRequest.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
-  factory MeasurePingRequest.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  TunOptions clone() => TunOptions()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  TunOptions copyWith(void Function(TunOptions) updates) => super.copyWith((message) => updates(message as TunOptions)) as TunOptions;
 
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'MeasurePingRequest', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
-    ..pPS(1, _omitFieldNames ? '' : 'url')
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static TunOptions create() => TunOptions._();
+  @$core.override
+  TunOptions createEmptyInstance() => create();
+  static $pb.PbList<TunOptions> createRepeated() => $pb.PbList<TunOptions>();
+  @$core.pragma('dart2js:noInline')
+  static TunOptions getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<TunOptions>(create);
+  static TunOptions? _defaultInstance;
+
+  /// 1500 when 0
+  @$pb.TagNumber(1)
+  $core.int get mtu => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set mtu($core.int value) => $_setSignedInt32(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasMtu() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearMtu() => $_clearField(1);
+
+  /// Engine default when 0
+  @$pb.TagNumber(2)
+  $core.int get udpTimeoutSec => $_getIZ(1);
+  @$pb.TagNumber(2)
+  set udpTimeoutSec($core.int value) => $_setSignedInt32(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasUdpTimeoutSec() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearUdpTimeoutSec() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.int get tcpSendBufferBytes => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set tcpSendBufferBytes($core.int value) => $_setSignedInt32(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasTcpSendBufferBytes() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearTcpSendBufferBytes() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.int get tcpReceiveBufferBytes => $_getIZ(3);
+  @$pb.TagNumber(4)
+  set tcpReceiveBufferBytes($core.int value) => $_setSignedInt32(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasTcpReceiveBufferBytes() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearTcpReceiveBufferBytes() => $_clearField(4);
+
+  /// TCP receive buffer auto-tuning
+  @$pb.TagNumber(5)
+  $core.bool get tcpModerateReceiveBuffer => $_getBF(4);
+  @$pb.TagNumber(5)
+  set tcpModerateReceiveBuffer($core.bool value) => $_setBool(4, value);
+  @$pb.TagNumber(5)
+  $core.bool hasTcpModerateReceiveBuffer() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearTcpModerateReceiveBuffer() => $_clearField(5);
+
+  /// debug, info (default), warning, error or silent
+  @$pb.TagNumber(6)
+  $core.String get logLevel => $_getSZ(5);
+  @$pb.TagNumber(6)
+  set logLevel($core.String value) => $_setString(5, value);
+  @$pb.TagNumber(6)
+  $core.bool hasLogLevel() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearLogLevel() => $_clearField(6);
+
+  /// host:port of the tun2socks REST API
+  @$pb.TagNumber(7)
+  $core.String get restApi => $_getSZ(6);
+  @$pb.TagNumber(7)
+  set restApi($core.String value) => $_setString(6, value);
+  @$pb.TagNumber(7)
+  $core.bool hasRestApi() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearRestApi() => $_clearField(7);
+
+  @$pb.TagNumber(8)
+  $pb.PbList<$core.String> get multicastGroups => $_getList(7);
+
+  /// DNS from the TUN device, through the proxy when unset
+  @$pb.TagNumber(9)
+  TunDnsOptions get dns => $_getN(8);
+  @$pb.TagNumber(9)
+  set dns(TunDnsOptions value) => $_setField(9, value);
+  @$pb.TagNumber(9)
+  $core.bool hasDns() => $_has(8);
+  @$pb.TagNumber(9)
+  void clearDns() => $_clearField(9);
+  @$pb.TagNumber(9)
+  TunDnsOptions ensureDns() => $_ensure(8);
+
+  /// Destinations dialed directly
+  @$pb.TagNumber(10)
+  TunBypassOptions get bypass => $_getN(9);
+  @$pb.TagNumber(10)
+  set bypass(TunBypassOptions value) => $_setField(10, value);
+  @$pb.TagNumber(10)
+  $core.bool hasBypass() => $_has(9);
+  @$pb.TagNumber(10)
+  void clearBypass() => $_clearField(10);
+  @$pb.TagNumber(10)
+  TunBypassOptions ensureBypass() => $_ensure(9);
+}
+
+/// Queries to port 53 over UDP and TCP are answered through the upstreams.
+/// Fake IPs are kept across tunnel restarts while the range is unchanged.
+class TunDnsOptions extends $pb.GeneratedMessage {
+  factory TunDnsOptions({
+    $core.Iterable<$core.String>? upstreams,
+    $core.bool? fakeIp,
+    $core.String? fakeIpRange,
+  }) {
+    final result = create();
+    if (upstreams != null) result.upstreams.addAll(upstreams);
+    if (fakeIp != null) result.fakeIp = fakeIp;
+    if (fakeIpRange != null) result.fakeIpRange = fakeIpRange;
+    return result;
+  }
+
+  TunDnsOptions._();
+
+  factory TunDnsOptions.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory TunDnsOptions.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'TunDnsOptions', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..pPS(1, _omitFieldNames ? '' : 'upstreams')
+    ..aOB(2, _omitFieldNames ? '' : 'fakeIp', protoName: 'fakeIp')
+    ..aOS(3, _omitFieldNames ? '' : 'fakeIpRange', protoName: 'fakeIpRange')
     ..hasRequiredFields = false
   ;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
-  MeasurePingRequest clone() => MeasurePingRequest()..mergeFromMessage(this);
+  TunDnsOptions clone() => TunDnsOptions()..mergeFromMessage(this);
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
-  MeasurePingRequest copyWith(void Function(MeasurePingRequest) updates) => super.copyWith((message) => updates(message as MeasurePingRequest)) as MeasurePingRequest;
+  TunDnsOptions copyWith(void Function(TunDnsOptions) updates) => super.copyWith((message) => updates(message as TunDnsOptions)) as TunDnsOptions;
 
   @$core.override
   $pb.BuilderInfo get info_ => _i;
 
   @$core.pragma('dart2js:noInline')
-  static MeasurePingRequest create() => MeasurePingRequest._();
+  static TunDnsOptions create() => TunDnsOptions._();
   @$core.override
-  MeasurePingRequest createEmptyInstance() => create();
-  static $pb.PbList<MeasurePingRequest> createRepeated() => $pb.PbList<MeasurePingRequest>();
+  TunDnsOptions createEmptyInstance() => create();
+  static $pb.PbList<TunDnsOptions> createRepeated() => $pb.PbList<TunDnsOptions>();
   @$core.pragma('dart2js:noInline')
-  static MeasurePingRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<MeasurePingRequest>(create);
-  static MeasurePingRequest? _defaultInstance;
+  static TunDnsOptions getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<TunDnsOptions>(create);
+  static TunDnsOptions? _defaultInstance;
 
+  /// https:// (DoH) or tls:// (DoT) URLs dialed through the core
   @$pb.TagNumber(1)
-  $pb.PbList<$core.String> get url => $_getList(0);
+  $pb.PbList<$core.String> get upstreams => $_getList(0);
+
+  /// Answer A queries with fake IPs mapped back to domains
+  @$pb.TagNumber(2)
+  $core.bool get fakeIp => $_getBF(1);
+  @$pb.TagNumber(2)
+  set fakeIp($core.bool value) => $_setBool(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasFakeIp() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearFakeIp() => $_clearField(2);
+
+  /// 198.18.0.0/15 when empty
+  @$pb.TagNumber(3)
+  $core.String get fakeIpRange => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set fakeIpRange($core.String value) => $_setString(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasFakeIpRange() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearFakeIpRange() => $_clearField(3);
 }
 
-class BooleanResponse extends $pb.GeneratedMessage {
-  factory BooleanResponse({
-    $core.bool? message,
+class TunBypassOptions extends $pb.GeneratedMessage {
+  factory TunBypassOptions({
+    $core.bool? private,
+    $core.Iterable<$core.String>? cidrs,
+    $core.Iterable<$core.String>? domains,
+    $core.Iterable<$core.String>? geoip,
   }) {
     final result = create();
-    if (message != null) result.message = message;
+    if (private != null) result.private = private;
+    if (cidrs != null) result.cidrs.addAll(cidrs);
+    if (domains != null) result.domains.addAll(domains);
+    if (geoip != null) result.geoip.addAll(geoip);
     return result;
   }
 
-  BooleanResponse._();
+  TunBypassOptions._();
 
-  factory BooleanResponse.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
-  factory BooleanResponse.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+  factory TunBypassOptions.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory TunBypassOptions.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
 
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'BooleanResponse', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
-    ..aOB(1, _omitFieldNames ? '' : 'message')
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'TunBypassOptions', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aOB(1, _omitFieldNames ? '' : 'private')
+    ..pPS(2, _omitFieldNames ? '' : 'cidrs')
+    ..pPS(3, _omitFieldNames ? '' : 'domains')
+    ..pPS(4, _omitFieldNames ? '' : 'geoip')
     ..hasRequiredFields = false
   ;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
-  BooleanResponse clone() => BooleanResponse()..mergeFromMessage(this);
+  TunBypassOptions clone() => TunBypassOptions()..mergeFromMessage(this);
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
-  BooleanResponse copyWith(void Function(BooleanResponse) updates) => super.copyWith((message) => updates(message as BooleanResponse)) as BooleanResponse;
+  TunBypassOptions copyWith(void Function(TunBypassOptions) updates) => super.copyWith((message) => updates(message as TunBypassOptions)) as TunBypassOptions;
 
   @$core.override
   $pb.BuilderInfo get info_ => _i;
 
   @$core.pragma('dart2js:noInline')
-  static BooleanResponse create() => BooleanResponse._();
+  static TunBypassOptions create() => TunBypassOptions._();
   @$core.override
-  BooleanResponse createEmptyInstance() => create();
-  static $pb.PbList<BooleanResponse> createRepeated() => $pb.PbList<BooleanResponse>();
+  TunBypassOptions createEmptyInstance() => create();
+  static $pb.PbList<TunBypassOptions> createRepeated() => $pb.PbList<TunBypassOptions>();
   @$core.pragma('dart2js:noInline')
-  static BooleanResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<BooleanResponse>(create);
-  static BooleanResponse? _defaultInstance;
+  static TunBypassOptions getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<TunBypassOptions>(create);
+  static TunBypassOptions? _defaultInstance;
 
+  /// Private, loopback and link-local ranges
   @$pb.TagNumber(1)
-  $core.bool get message => $_getBF(0);
+  $core.bool get private => $_getBF(0);
   @$pb.TagNumber(1)
-  set message($core.bool value) => $_setBool(0, value);
+  set private($core.bool value) => $_setBool(0, value);
   @$pb.TagNumber(1)
-  $core.bool hasMessage() => $_has(0);
+  $core.bool hasPrivate() => $_has(0);
   @$pb.TagNumber(1)
-  void clearMessage() => $_clearField(1);
+  void clearPrivate() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $pb.PbList<$core.String> get cidrs => $_getList(1);
+
+  /// Domains and their subdomains, needs fakeIp
+  @$pb.TagNumber(3)
+  $pb.PbList<$core.String> get domains => $_getList(2);
+
+  /// Country codes from geoip.dat in dir
+  @$pb.TagNumber(4)
+  $pb.PbList<$core.String> get geoip => $_getList(3);
 }
 
-class VersionResponse extends $pb.GeneratedMessage {
-  factory VersionResponse({
-    $core.String? message,
+class ProxyAuth extends $pb.GeneratedMessage {
+  factory ProxyAuth({
+    $core.String? username,
+    $core.String? password,
   }) {
     final result = create();
-    if (message != null) result.message = message;
+    if (username != null) result.username = username;
+    if (password != null) result.password = password;
     return result;
   }
 
-  VersionResponse._();
+  ProxyAuth._();
 
-  factory VersionResponse.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
-  factory VersionResponse.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+  factory ProxyAuth.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory ProxyAuth.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
 
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'VersionResponse', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
-    ..aOS(1, _omitFieldNames ? '' : 'message')
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'ProxyAuth', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'username')
+    ..aOS(2, _omitFieldNames ? '' : 'password')
     ..hasRequiredFields = false
   ;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
-  VersionResponse clone() => VersionResponse()..mergeFromMessage(this);
+  ProxyAuth clone() => ProxyAuth()..mergeFromMessage(this);
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
-  VersionResponse copyWith(void Function(VersionResponse) updates) => super.copyWith((message) => updates(message as VersionResponse)) as VersionResponse;
+  ProxyAuth copyWith(void Function(ProxyAuth) updates) => super.copyWith((message) => updates(message as ProxyAuth)) as ProxyAuth;
 
   @$core.override
   $pb.BuilderInfo get info_ => _i;
 
   @$core.pragma('dart2js:noInline')
-  static VersionResponse create() => VersionResponse._();
+  static ProxyAuth create() => ProxyAuth._();
   @$core.override
-  VersionResponse createEmptyInstance() => create();
-  static $pb.PbList<VersionResponse> createRepeated() => $pb.PbList<VersionResponse>();
+  ProxyAuth createEmptyInstance() => create();
+  static $pb.PbList<ProxyAuth> createRepeated() => $pb.PbList<ProxyAuth>();
   @$core.pragma('dart2js:noInline')
-  static VersionResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<VersionResponse>(create);
-  static VersionResponse? _defaultInstance;
+  static ProxyAuth getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ProxyAuth>(create);
+  static ProxyAuth? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get message => $_getSZ(0);
+  $core.String get username => $_getSZ(0);
   @$pb.TagNumber(1)
-  set message($core.String value) => $_setString(0, value);
+  set username($core.String value) => $_setString(0, value);
   @$pb.TagNumber(1)
-  $core.bool hasMessage() => $_has(0);
+  $core.bool hasUsername() => $_has(0);
   @$pb.TagNumber(1)
-  void clearMessage() => $_clearField(1);
+  void clearUsername() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get password => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set password($core.String value) => $_setString(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasPassword() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearPassword() => $_clearField(2);
 }
 
-class LogResponse extends $pb.GeneratedMessage {
-  factory LogResponse({
-    $core.String? logs,
+class PoolOptions extends $pb.GeneratedMessage {
+  factory PoolOptions({
+    $core.Iterable<$core.String>? servers,
+    $core.String? strategy,
+    $core.String? probeUrl,
+    $core.int? probeIntervalSec,
   }) {
     final result = create();
-    if (logs != null) result.logs = logs;
+    if (servers != null) result.servers.addAll(servers);
+    if (strategy != null) result.strategy = strategy;
+    if (probeUrl != null) result.probeUrl = probeUrl;
+    if (probeIntervalSec != null) result.probeIntervalSec = probeIntervalSec;
     return result;
   }
 
-  LogResponse._();
+  PoolOptions._();
 
-  factory LogResponse.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
-  factory LogResponse.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+  factory PoolOptions.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory PoolOptions.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
 
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'LogResponse', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
-    ..aOS(1, _omitFieldNames ? '' : 'logs')
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'PoolOptions', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..pPS(1, _omitFieldNames ? '' : 'servers')
+    ..aOS(2, _omitFieldNames ? '' : 'strategy')
+    ..aOS(3, _omitFieldNames ? '' : 'probeUrl', protoName: 'probeUrl')
+    ..a<$core.int>(4, _omitFieldNames ? '' : 'probeIntervalSec', $pb.PbFieldType.O3, protoName: 'probeIntervalSec')
     ..hasRequiredFields = false
   ;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
-  LogResponse clone() => LogResponse()..mergeFromMessage(this);
+  PoolOptions clone() => PoolOptions()..mergeFromMessage(this);
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
-  LogResponse copyWith(void Function(LogResponse) updates) => super.copyWith((message) => updates(message as LogResponse)) as LogResponse;
+  PoolOptions copyWith(void Function(PoolOptions) updates) => super.copyWith((message) => updates(message as PoolOptions)) as PoolOptions;
 
   @$core.override
   $pb.BuilderInfo get info_ => _i;
 
   @$core.pragma('dart2js:noInline')
-  static LogResponse create() => LogResponse._();
+  static PoolOptions create() => PoolOptions._();
   @$core.override
-  LogResponse createEmptyInstance() => create();
-  static $pb.PbList<LogResponse> createRepeated() => $pb.PbList<LogResponse>();
+  PoolOptions createEmptyInstance() => create();
+  static $pb.PbList<PoolOptions> createRepeated() => $pb.PbList<PoolOptions>();
   @$core.pragma('dart2js:noInline')
-  static LogResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<LogResponse>(create);
-  static LogResponse? _defaultInstance;
+  static PoolOptions getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<PoolOptions>(create);
+  static PoolOptions? _defaultInstance;
 
+  /// Share links or outbound JSON for xray
   @$pb.TagNumber(1)
-  $core.String get logs => $_getSZ(0);
-  @$pb.TagNumber(1)
-  set logs($core.String value) => $_setString(0, value);
-  @$pb.TagNumber(1)
-  $core.bool hasLogs() => $_has(0);
-  @$pb.TagNumber(1)
-  void clearLogs() => $_clearField(1);
+  $pb.PbList<$core.String> get servers => $_getList(0);
+
+  /// leastPing (default), leastLoad, roundRobin or random; outline runs leastLoad as leastPing and random as roundRobin
+  @$pb.TagNumber(2)
+  $core.String get strategy => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set strategy($core.String value) => $_setString(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasStrategy() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearStrategy() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get probeUrl => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set probeUrl($core.String value) => $_setString(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasProbeUrl() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearProbeUrl() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.int get probeIntervalSec => $_getIZ(3);
+  @$pb.TagNumber(4)
+  set probeIntervalSec($core.int value) => $_setSignedInt32(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasProbeIntervalSec() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearProbeIntervalSec() => $_clearField(4);
 }
 
-class MeasurePingResponse extends $pb.GeneratedMessage {
-  factory MeasurePingResponse({
-    $core.Iterable<PingResult>? results,
+class MeasurePingRequest extends $pb.GeneratedMessage {
+  factory MeasurePingRequest({
+    $core.Iterable<$core.String>? url,
   }) {
     final result = create();
-    if (results != null) result.results.addAll(results);
+    if (url != null) result.url.addAll(url);
     return result;
   }
 
-  MeasurePingResponse._();
+  MeasurePingRequest._();
 
-  factory MeasurePingResponse.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
-  factory MeasurePingResponse.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+  factory MeasurePingRequest.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory MeasurePingRequest.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
 
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'MeasurePingResponse', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
-    ..pc<PingResult>(1, _omitFieldNames ? '' : 'results', $pb.PbFieldType.PM, subBuilder: PingResult.create)
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'MeasurePingRequest', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..pPS(1, _omitFieldNames ? '' : 'url')
     ..hasRequiredFields = false
   ;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
-  MeasurePingResponse clone() => MeasurePingResponse()..mergeFromMessage(this);
+  MeasurePingRequest clone() => MeasurePingRequest()..mergeFromMessage(this);
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
-  MeasurePingResponse copyWith(void Function(MeasurePingResponse) updates) => super.copyWith((message) => updates(message as MeasurePingResponse)) as MeasurePingResponse;
+  MeasurePingRequest copyWith(void Function(MeasurePingRequest) updates) => super.copyWith((message) => updates(message as MeasurePingRequest)) as MeasurePingRequest;
 
   @$core.override
   $pb.BuilderInfo get info_ => _i;
 
   @$core.pragma('dart2js:noInline')
-  static MeasurePingResponse create() => MeasurePingResponse._();
+  static MeasurePingRequest create() => MeasurePingRequest._();
   @$core.override
-  MeasurePingResponse createEmptyInstance() => create();
-  static $pb.PbList<MeasurePingResponse> createRepeated() => $pb.PbList<MeasurePingResponse>();
+  MeasurePingRequest createEmptyInstance() => create();
+  static $pb.PbList<MeasurePingRequest> createRepeated() => $pb.PbList<MeasurePingRequest>();
   @$core.pragma('dart2js:noInline')
-  static MeasurePingResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<MeasurePingResponse>(create);
-  static MeasurePingResponse? _defaultInstance;
+  static MeasurePingRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<MeasurePingRequest>(create);
+  static MeasurePingRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $pb.PbList<PingResult> get results => $_getList(0);
+  $pb.PbList<$core.String> get url => $_getList(0);
 }
 
-class PingResult extends $pb.GeneratedMessage {
-  factory PingResult({
-    $core.String? url,
-    $fixnum.Int64? delay,
+class StreamLogsRequest extends $pb.GeneratedMessage {
+  factory StreamLogsRequest({
+    $core.bool? includeBacklog,
   }) {
     final result = create();
-    if (url != null) result.url = url;
-    if (delay != null) result.delay = delay;
+    if (includeBacklog != null) result.includeBacklog = includeBacklog;
     return result;
   }
 
-  PingResult._();
+  StreamLogsRequest._();
 
-  factory PingResult.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
-  factory PingResult.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+  factory StreamLogsRequest.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory StreamLogsRequest.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
 
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'PingResult', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
-    ..aOS(1, _omitFieldNames ? '' : 'url')
-    ..aInt64(2, _omitFieldNames ? '' : 'delay')
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'StreamLogsRequest', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aOB(1, _omitFieldNames ? '' : 'includeBacklog', protoName: 'includeBacklog')
     ..hasRequiredFields = false
   ;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
-  PingResult clone() => PingResult()..mergeFromMessage(this);
+  StreamLogsRequest clone() => StreamLogsRequest()..mergeFromMessage(this);
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
-  PingResult copyWith(void Function(PingResult) updates) => super.copyWith((message) => updates(message as PingResult)) as PingResult;
+  StreamLogsRequest copyWith(void Function(StreamLogsRequest) updates) => super.copyWith((message) => updates(message as StreamLogsRequest)) as StreamLogsRequest;
 
   @$core.override
   $pb.BuilderInfo get info_ => _i;
 
   @$core.pragma('dart2js:noInline')
-  static PingResult create() => PingResult._();
+  static StreamLogsRequest create() => StreamLogsRequest._();
   @$core.override
-  PingResult createEmptyInstance() => create();
-  static $pb.PbList<PingResult> createRepeated() => $pb.PbList<PingResult>();
+  StreamLogsRequest createEmptyInstance() => create();
+  static $pb.PbList<StreamLogsRequest> createRepeated() => $pb.PbList<StreamLogsRequest>();
   @$core.pragma('dart2js:noInline')
-  static PingResult getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<PingResult>(create);
-  static PingResult? _defaultInstance;
+  static StreamLogsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<StreamLogsRequest>(create);
+  static StreamLogsRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get url => $_getSZ(0);
+  $core.bool get includeBacklog => $_getBF(0);
   @$pb.TagNumber(1)
-  set url($core.String value) => $_setString(0, value);
+  set includeBacklog($core.bool value) => $_setBool(0, value);
   @$pb.TagNumber(1)
-  $core.bool hasUrl() => $_has(0);
+  $core.bool hasIncludeBacklog() => $_has(0);
   @$pb.TagNumber(1)
-  void clearUrl() => $_clearField(1);
+  void clearIncludeBacklog() => $_clearField(1);
+}
 
-  @$pb.TagNumber(2)
-  $fixnum.Int64 get delay => $_getI64(1);
-  @$pb.TagNumber(2)
-  set delay($fixnum.Int64 value) => $_setInt64(1, value);
-  @$pb.TagNumber(2)
-  $core.bool hasDelay() => $_has(1);
-  @$pb.TagNumber(2)
-  void clearDelay() => $_clearField(2);
+class TrafficStatsRequest extends $pb.GeneratedMessage {
+  factory TrafficStatsRequest({
+    $core.bool? resetCounters,
+  }) {
+    final result = create();
+    if (resetCounters != null) result.resetCounters = resetCounters;
+    return result;
+  }
+
+  TrafficStatsRequest._();
+
+  factory TrafficStatsRequest.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory TrafficStatsRequest.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'TrafficStatsRequest', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aOB(1, _omitFieldNames ? '' : 'resetCounters', protoName: 'resetCounters')
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  TrafficStatsRequest clone() => TrafficStatsRequest()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  TrafficStatsRequest copyWith(void Function(TrafficStatsRequest) updates) => super.copyWith((message) => updates(message as TrafficStatsRequest)) as TrafficStatsRequest;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static TrafficStatsRequest create() => TrafficStatsRequest._();
+  @$core.override
+  TrafficStatsRequest createEmptyInstance() => create();
+  static $pb.PbList<TrafficStatsRequest> createRepeated() => $pb.PbList<TrafficStatsRequest>();
+  @$core.pragma('dart2js:noInline')
+  static TrafficStatsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<TrafficStatsRequest>(create);
+  static TrafficStatsRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.bool get resetCounters => $_getBF(0);
+  @$pb.TagNumber(1)
+  set resetCounters($core.bool value) => $_setBool(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasResetCounters() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearResetCounters() => $_clearField(1);
+}
+
+class CloseConnectionRequest extends $pb.GeneratedMessage {
+  factory CloseConnectionRequest({
+    $fixnum.Int64? id,
+  }) {
+    final result = create();
+    if (id != null) result.id = id;
+    return result;
+  }
+
+  CloseConnectionRequest._();
+
+  factory CloseConnectionRequest.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory CloseConnectionRequest.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'CloseConnectionRequest', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..a<$fixnum.Int64>(1, _omitFieldNames ? '' : 'id', $pb.PbFieldType.OU6, defaultOrMaker: $fixnum.Int64.ZERO)
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  CloseConnectionRequest clone() => CloseConnectionRequest()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  CloseConnectionRequest copyWith(void Function(CloseConnectionRequest) updates) => super.copyWith((message) => updates(message as CloseConnectionRequest)) as CloseConnectionRequest;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static CloseConnectionRequest create() => CloseConnectionRequest._();
+  @$core.override
+  CloseConnectionRequest createEmptyInstance() => create();
+  static $pb.PbList<CloseConnectionRequest> createRepeated() => $pb.PbList<CloseConnectionRequest>();
+  @$core.pragma('dart2js:noInline')
+  static CloseConnectionRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CloseConnectionRequest>(create);
+  static CloseConnectionRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $fixnum.Int64 get id => $_getI64(0);
+  @$pb.TagNumber(1)
+  set id($fixnum.Int64 value) => $_setInt64(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasId() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearId() => $_clearField(1);
+}
+
+class ConvertShareLinkRequest extends $pb.GeneratedMessage {
+  factory ConvertShareLinkRequest({
+    $core.String? link,
+    $core.int? proxyPort,
+  }) {
+    final result = create();
+    if (link != null) result.link = link;
+    if (proxyPort != null) result.proxyPort = proxyPort;
+    return result;
+  }
+
+  ConvertShareLinkRequest._();
+
+  factory ConvertShareLinkRequest.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory ConvertShareLinkRequest.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'ConvertShareLinkRequest', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'link')
+    ..a<$core.int>(2, _omitFieldNames ? '' : 'proxyPort', $pb.PbFieldType.O3, protoName: 'proxyPort')
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ConvertShareLinkRequest clone() => ConvertShareLinkRequest()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ConvertShareLinkRequest copyWith(void Function(ConvertShareLinkRequest) updates) => super.copyWith((message) => updates(message as ConvertShareLinkRequest)) as ConvertShareLinkRequest;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ConvertShareLinkRequest create() => ConvertShareLinkRequest._();
+  @$core.override
+  ConvertShareLinkRequest createEmptyInstance() => create();
+  static $pb.PbList<ConvertShareLinkRequest> createRepeated() => $pb.PbList<ConvertShareLinkRequest>();
+  @$core.pragma('dart2js:noInline')
+  static ConvertShareLinkRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ConvertShareLinkRequest>(create);
+  static ConvertShareLinkRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get link => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set link($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasLink() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearLink() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.int get proxyPort => $_getIZ(1);
+  @$pb.TagNumber(2)
+  set proxyPort($core.int value) => $_setSignedInt32(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasProxyPort() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearProxyPort() => $_clearField(2);
+}
+
+class FetchSubscriptionRequest extends $pb.GeneratedMessage {
+  factory FetchSubscriptionRequest({
+    $core.String? url,
+    $core.bool? viaCore,
+    $core.String? userAgent,
+    $core.int? timeoutMs,
+  }) {
+    final result = create();
+    if (url != null) result.url = url;
+    if (viaCore != null) result.viaCore = viaCore;
+    if (userAgent != null) result.userAgent = userAgent;
+    if (timeoutMs != null) result.timeoutMs = timeoutMs;
+    return result;
+  }
+
+  FetchSubscriptionRequest._();
+
+  factory FetchSubscriptionRequest.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory FetchSubscriptionRequest.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'FetchSubscriptionRequest', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'url')
+    ..aOB(2, _omitFieldNames ? '' : 'viaCore', protoName: 'viaCore')
+    ..aOS(3, _omitFieldNames ? '' : 'userAgent', protoName: 'userAgent')
+    ..a<$core.int>(4, _omitFieldNames ? '' : 'timeoutMs', $pb.PbFieldType.O3, protoName: 'timeoutMs')
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  FetchSubscriptionRequest clone() => FetchSubscriptionRequest()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  FetchSubscriptionRequest copyWith(void Function(FetchSubscriptionRequest) updates) => super.copyWith((message) => updates(message as FetchSubscriptionRequest)) as FetchSubscriptionRequest;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static FetchSubscriptionRequest create() => FetchSubscriptionRequest._();
+  @$core.override
+  FetchSubscriptionRequest createEmptyInstance() => create();
+  static $pb.PbList<FetchSubscriptionRequest> createRepeated() => $pb.PbList<FetchSubscriptionRequest>();
+  @$core.pragma('dart2js:noInline')
+  static FetchSubscriptionRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<FetchSubscriptionRequest>(create);
+  static FetchSubscriptionRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get url => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set url($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasUrl() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearUrl() => $_clearField(1);
+
+  /// Fetch through the running core instead of directly
+  @$pb.TagNumber(2)
+  $core.bool get viaCore => $_getBF(1);
+  @$pb.TagNumber(2)
+  set viaCore($core.bool value) => $_setBool(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasViaCore() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearViaCore() => $_clearField(2);
+
+  /// Optional, defaults to a v2rayNG agent
+  @$pb.TagNumber(3)
+  $core.String get userAgent => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set userAgent($core.String value) => $_setString(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasUserAgent() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearUserAgent() => $_clearField(3);
+
+  /// Optional, defaults to 15000
+  @$pb.TagNumber(4)
+  $core.int get timeoutMs => $_getIZ(3);
+  @$pb.TagNumber(4)
+  set timeoutMs($core.int value) => $_setSignedInt32(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasTimeoutMs() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearTimeoutMs() => $_clearField(4);
+}
+
+class TestConfigsRequest extends $pb.GeneratedMessage {
+  factory TestConfigsRequest({
+    $core.Iterable<TestConfig>? configs,
+    $core.String? url,
+    $core.int? concurrency,
+    $core.int? timeoutMs,
+  }) {
+    final result = create();
+    if (configs != null) result.configs.addAll(configs);
+    if (url != null) result.url = url;
+    if (concurrency != null) result.concurrency = concurrency;
+    if (timeoutMs != null) result.timeoutMs = timeoutMs;
+    return result;
+  }
+
+  TestConfigsRequest._();
+
+  factory TestConfigsRequest.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory TestConfigsRequest.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'TestConfigsRequest', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..pc<TestConfig>(1, _omitFieldNames ? '' : 'configs', $pb.PbFieldType.PM, subBuilder: TestConfig.create)
+    ..aOS(2, _omitFieldNames ? '' : 'url')
+    ..a<$core.int>(3, _omitFieldNames ? '' : 'concurrency', $pb.PbFieldType.O3)
+    ..a<$core.int>(4, _omitFieldNames ? '' : 'timeoutMs', $pb.PbFieldType.O3, protoName: 'timeoutMs')
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  TestConfigsRequest clone() => TestConfigsRequest()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  TestConfigsRequest copyWith(void Function(TestConfigsRequest) updates) => super.copyWith((message) => updates(message as TestConfigsRequest)) as TestConfigsRequest;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static TestConfigsRequest create() => TestConfigsRequest._();
+  @$core.override
+  TestConfigsRequest createEmptyInstance() => create();
+  static $pb.PbList<TestConfigsRequest> createRepeated() => $pb.PbList<TestConfigsRequest>();
+  @$core.pragma('dart2js:noInline')
+  static TestConfigsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<TestConfigsRequest>(create);
+  static TestConfigsRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $pb.PbList<TestConfig> get configs => $_getList(0);
+
+  /// Optional, defaults to generate_204
+  @$pb.TagNumber(2)
+  $core.String get url => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set url($core.String value) => $_setString(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasUrl() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearUrl() => $_clearField(2);
+
+  /// Optional, defaults to 8
+  @$pb.TagNumber(3)
+  $core.int get concurrency => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set concurrency($core.int value) => $_setSignedInt32(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasConcurrency() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearConcurrency() => $_clearField(3);
+
+  /// Per config, optional, defaults to 10000
+  @$pb.TagNumber(4)
+  $core.int get timeoutMs => $_getIZ(3);
+  @$pb.TagNumber(4)
+  set timeoutMs($core.int value) => $_setSignedInt32(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasTimeoutMs() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearTimeoutMs() => $_clearField(4);
+}
+
+class TestConfig extends $pb.GeneratedMessage {
+  factory TestConfig({
+    $core.String? id,
+    $core.String? coreName,
+    $core.String? config,
+  }) {
+    final result = create();
+    if (id != null) result.id = id;
+    if (coreName != null) result.coreName = coreName;
+    if (config != null) result.config = config;
+    return result;
+  }
+
+  TestConfig._();
+
+  factory TestConfig.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory TestConfig.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'TestConfig', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'id')
+    ..aOS(2, _omitFieldNames ? '' : 'coreName', protoName: 'coreName')
+    ..aOS(3, _omitFieldNames ? '' : 'config')
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  TestConfig clone() => TestConfig()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  TestConfig copyWith(void Function(TestConfig) updates) => super.copyWith((message) => updates(message as TestConfig)) as TestConfig;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static TestConfig create() => TestConfig._();
+  @$core.override
+  TestConfig createEmptyInstance() => create();
+  static $pb.PbList<TestConfig> createRepeated() => $pb.PbList<TestConfig>();
+  @$core.pragma('dart2js:noInline')
+  static TestConfig getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<TestConfig>(create);
+  static TestConfig? _defaultInstance;
+
+  /// Echoed back in the result
+  @$pb.TagNumber(1)
+  $core.String get id => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set id($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasId() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearId() => $_clearField(1);
+
+  /// Optional, detected from config when empty
+  @$pb.TagNumber(2)
+  $core.String get coreName => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set coreName($core.String value) => $_setString(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasCoreName() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearCoreName() => $_clearField(2);
+
+  /// Xray JSON, share link or Outline SSConfig JSON
+  @$pb.TagNumber(3)
+  $core.String get config => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set config($core.String value) => $_setString(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasConfig() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearConfig() => $_clearField(3);
+}
+
+class BooleanResponse extends $pb.GeneratedMessage {
+  factory BooleanResponse({
+    $core.bool? message,
+  }) {
+    final result = create();
+    if (message != null) result.message = message;
+    return result;
+  }
+
+  BooleanResponse._();
+
+  factory BooleanResponse.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory BooleanResponse.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'BooleanResponse', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aOB(1, _omitFieldNames ? '' : 'message')
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  BooleanResponse clone() => BooleanResponse()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  BooleanResponse copyWith(void Function(BooleanResponse) updates) => super.copyWith((message) => updates(message as BooleanResponse)) as BooleanResponse;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static BooleanResponse create() => BooleanResponse._();
+  @$core.override
+  BooleanResponse createEmptyInstance() => create();
+  static $pb.PbList<BooleanResponse> createRepeated() => $pb.PbList<BooleanResponse>();
+  @$core.pragma('dart2js:noInline')
+  static BooleanResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<BooleanResponse>(create);
+  static BooleanResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.bool get message => $_getBF(0);
+  @$pb.TagNumber(1)
+  set message($core.bool value) => $_setBool(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasMessage() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearMessage() => $_clearField(1);
+}
+
+class VersionResponse extends $pb.GeneratedMessage {
+  factory VersionResponse({
+    $core.String? message,
+  }) {
+    final result = create();
+    if (message != null) result.message = message;
+    return result;
+  }
+
+  VersionResponse._();
+
+  factory VersionResponse.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory VersionResponse.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'VersionResponse', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'message')
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  VersionResponse clone() => VersionResponse()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  VersionResponse copyWith(void Function(VersionResponse) updates) => super.copyWith((message) => updates(message as VersionResponse)) as VersionResponse;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static VersionResponse create() => VersionResponse._();
+  @$core.override
+  VersionResponse createEmptyInstance() => create();
+  static $pb.PbList<VersionResponse> createRepeated() => $pb.PbList<VersionResponse>();
+  @$core.pragma('dart2js:noInline')
+  static VersionResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<VersionResponse>(create);
+  static VersionResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get message => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set message($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasMessage() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearMessage() => $_clearField(1);
+}
+
+class LogResponse extends $pb.GeneratedMessage {
+  factory LogResponse({
+    $core.String? logs,
+  }) {
+    final result = create();
+    if (logs != null) result.logs = logs;
+    return result;
+  }
+
+  LogResponse._();
+
+  factory LogResponse.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory LogResponse.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'LogResponse', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'logs')
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  LogResponse clone() => LogResponse()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  LogResponse copyWith(void Function(LogResponse) updates) => super.copyWith((message) => updates(message as LogResponse)) as LogResponse;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static LogResponse create() => LogResponse._();
+  @$core.override
+  LogResponse createEmptyInstance() => create();
+  static $pb.PbList<LogResponse> createRepeated() => $pb.PbList<LogResponse>();
+  @$core.pragma('dart2js:noInline')
+  static LogResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<LogResponse>(create);
+  static LogResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get logs => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set logs($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasLogs() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearLogs() => $_clearField(1);
+}
+
+class MeasurePingResponse extends $pb.GeneratedMessage {
+  factory MeasurePingResponse({
+    $core.Iterable<PingResult>? results,
+  }) {
+    final result = create();
+    if (results != null) result.results.addAll(results);
+    return result;
+  }
+
+  MeasurePingResponse._();
+
+  factory MeasurePingResponse.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory MeasurePingResponse.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'MeasurePingResponse', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..pc<PingResult>(1, _omitFieldNames ? '' : 'results', $pb.PbFieldType.PM, subBuilder: PingResult.create)
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  MeasurePingResponse clone() => MeasurePingResponse()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  MeasurePingResponse copyWith(void Function(MeasurePingResponse) updates) => super.copyWith((message) => updates(message as MeasurePingResponse)) as MeasurePingResponse;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static MeasurePingResponse create() => MeasurePingResponse._();
+  @$core.override
+  MeasurePingResponse createEmptyInstance() => create();
+  static $pb.PbList<MeasurePingResponse> createRepeated() => $pb.PbList<MeasurePingResponse>();
+  @$core.pragma('dart2js:noInline')
+  static MeasurePingResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<MeasurePingResponse>(create);
+  static MeasurePingResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $pb.PbList<PingResult> get results => $_getList(0);
+}
+
+class PingResult extends $pb.GeneratedMessage {
+  factory PingResult({
+    $core.String? url,
+    $fixnum.Int64? delay,
+    $fixnum.Int64? dnsDelay,
+    $fixnum.Int64? connectDelay,
+    $fixnum.Int64? tlsDelay,
+    $fixnum.Int64? firstByteDelay,
+    $core.int? statusCode,
+    PingErrorCategory? errorCategory,
+    $core.String? error,
+  }) {
+    final result = create();
+    if (url != null) result.url = url;
+    if (delay != null) result.delay = delay;
+    if (dnsDelay != null) result.dnsDelay = dnsDelay;
+    if (connectDelay != null) result.connectDelay = connectDelay;
+    if (tlsDelay != null) result.tlsDelay = tlsDelay;
+    if (firstByteDelay != null) result.firstByteDelay = firstByteDelay;
+    if (statusCode != null) result.statusCode = statusCode;
+    if (errorCategory != null) result.errorCategory = errorCategory;
+    if (error != null) result.error = error;
+    return result;
+  }
+
+  PingResult._();
+
+  factory PingResult.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory PingResult.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'PingResult', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'url')
+    ..aInt64(2, _omitFieldNames ? '' : 'delay')
+    ..aInt64(3, _omitFieldNames ? '' : 'dnsDelay', protoName: 'dnsDelay')
+    ..aInt64(4, _omitFieldNames ? '' : 'connectDelay', protoName: 'connectDelay')
+    ..aInt64(5, _omitFieldNames ? '' : 'tlsDelay', protoName: 'tlsDelay')
+    ..aInt64(6, _omitFieldNames ? '' : 'firstByteDelay', protoName: 'firstByteDelay')
+    ..a<$core.int>(7, _omitFieldNames ? '' : 'statusCode', $pb.PbFieldType.O3, protoName: 'statusCode')
+    ..e<PingErrorCategory>(8, _omitFieldNames ? '' : 'errorCategory', $pb.PbFieldType.OE, protoName: 'errorCategory', defaultOrMaker: PingErrorCategory.PING_ERROR_NONE, valueOf: PingErrorCategory.valueOf, enumValues: PingErrorCategory.values)
+    ..aOS(9, _omitFieldNames ? '' : 'error')
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  PingResult clone() => PingResult()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  PingResult copyWith(void Function(PingResult) updates) => super.copyWith((message) => updates(message as PingResult)) as PingResult;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static PingResult create() => PingResult._();
+  @$core.override
+  PingResult createEmptyInstance() => create();
+  static $pb.PbList<PingResult> createRepeated() => $pb.PbList<PingResult>();
+  @$core.pragma('dart2js:noInline')
+  static PingResult getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<PingResult>(create);
+  static PingResult? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get url => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set url($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasUrl() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearUrl() => $_clearField(1);
+
+  /// Whole request in milliseconds, -1 on failure
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get delay => $_getI64(1);
+  @$pb.TagNumber(2)
+  set delay($fixnum.Int64 value) => $_setInt64(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasDelay() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearDelay() => $_clearField(2);
+
+  /// Milliseconds, phases that did not run are 0
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get dnsDelay => $_getI64(2);
+  @$pb.TagNumber(3)
+  set dnsDelay($fixnum.Int64 value) => $_setInt64(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasDnsDelay() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearDnsDelay() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get connectDelay => $_getI64(3);
+  @$pb.TagNumber(4)
+  set connectDelay($fixnum.Int64 value) => $_setInt64(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasConnectDelay() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearConnectDelay() => $_clearField(4);
+
+  @$pb.TagNumber(5)
+  $fixnum.Int64 get tlsDelay => $_getI64(4);
+  @$pb.TagNumber(5)
+  set tlsDelay($fixnum.Int64 value) => $_setInt64(4, value);
+  @$pb.TagNumber(5)
+  $core.bool hasTlsDelay() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearTlsDelay() => $_clearField(5);
+
+  /// From the start of the request
+  @$pb.TagNumber(6)
+  $fixnum.Int64 get firstByteDelay => $_getI64(5);
+  @$pb.TagNumber(6)
+  set firstByteDelay($fixnum.Int64 value) => $_setInt64(5, value);
+  @$pb.TagNumber(6)
+  $core.bool hasFirstByteDelay() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearFirstByteDelay() => $_clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.int get statusCode => $_getIZ(6);
+  @$pb.TagNumber(7)
+  set statusCode($core.int value) => $_setSignedInt32(6, value);
+  @$pb.TagNumber(7)
+  $core.bool hasStatusCode() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearStatusCode() => $_clearField(7);
+
+  @$pb.TagNumber(8)
+  PingErrorCategory get errorCategory => $_getN(7);
+  @$pb.TagNumber(8)
+  set errorCategory(PingErrorCategory value) => $_setField(8, value);
+  @$pb.TagNumber(8)
+  $core.bool hasErrorCategory() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearErrorCategory() => $_clearField(8);
+
+  @$pb.TagNumber(9)
+  $core.String get error => $_getSZ(8);
+  @$pb.TagNumber(9)
+  set error($core.String value) => $_setString(8, value);
+  @$pb.TagNumber(9)
+  $core.bool hasError() => $_has(8);
+  @$pb.TagNumber(9)
+  void clearError() => $_clearField(9);
+}
+
+class TrafficStatsResponse extends $pb.GeneratedMessage {
+  factory TrafficStatsResponse({
+    $fixnum.Int64? uplink,
+    $fixnum.Int64? downlink,
+    $fixnum.Int64? uplinkRate,
+    $fixnum.Int64? downlinkRate,
+    $core.int? connections,
+  }) {
+    final result = create();
+    if (uplink != null) result.uplink = uplink;
+    if (downlink != null) result.downlink = downlink;
+    if (uplinkRate != null) result.uplinkRate = uplinkRate;
+    if (downlinkRate != null) result.downlinkRate = downlinkRate;
+    if (connections != null) result.connections = connections;
+    return result;
+  }
+
+  TrafficStatsResponse._();
+
+  factory TrafficStatsResponse.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory TrafficStatsResponse.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'TrafficStatsResponse', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aInt64(1, _omitFieldNames ? '' : 'uplink')
+    ..aInt64(2, _omitFieldNames ? '' : 'downlink')
+    ..aInt64(3, _omitFieldNames ? '' : 'uplinkRate', protoName: 'uplinkRate')
+    ..aInt64(4, _omitFieldNames ? '' : 'downlinkRate', protoName: 'downlinkRate')
+    ..a<$core.int>(5, _omitFieldNames ? '' : 'connections', $pb.PbFieldType.O3)
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  TrafficStatsResponse clone() => TrafficStatsResponse()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  TrafficStatsResponse copyWith(void Function(TrafficStatsResponse) updates) => super.copyWith((message) => updates(message as TrafficStatsResponse)) as TrafficStatsResponse;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static TrafficStatsResponse create() => TrafficStatsResponse._();
+  @$core.override
+  TrafficStatsResponse createEmptyInstance() => create();
+  static $pb.PbList<TrafficStatsResponse> createRepeated() => $pb.PbList<TrafficStatsResponse>();
+  @$core.pragma('dart2js:noInline')
+  static TrafficStatsResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<TrafficStatsResponse>(create);
+  static TrafficStatsResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $fixnum.Int64 get uplink => $_getI64(0);
+  @$pb.TagNumber(1)
+  set uplink($fixnum.Int64 value) => $_setInt64(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasUplink() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearUplink() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get downlink => $_getI64(1);
+  @$pb.TagNumber(2)
+  set downlink($fixnum.Int64 value) => $_setInt64(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasDownlink() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearDownlink() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get uplinkRate => $_getI64(2);
+  @$pb.TagNumber(3)
+  set uplinkRate($fixnum.Int64 value) => $_setInt64(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasUplinkRate() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearUplinkRate() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get downlinkRate => $_getI64(3);
+  @$pb.TagNumber(4)
+  set downlinkRate($fixnum.Int64 value) => $_setInt64(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasDownlinkRate() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearDownlinkRate() => $_clearField(4);
+
+  /// Live connections of the core
+  @$pb.TagNumber(5)
+  $core.int get connections => $_getIZ(4);
+  @$pb.TagNumber(5)
+  set connections($core.int value) => $_setSignedInt32(4, value);
+  @$pb.TagNumber(5)
+  $core.bool hasConnections() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearConnections() => $_clearField(5);
+}
+
+class TunStatsResponse extends $pb.GeneratedMessage {
+  factory TunStatsResponse({
+    $fixnum.Int64? packetsIn,
+    $fixnum.Int64? packetsOut,
+    $fixnum.Int64? bytesIn,
+    $fixnum.Int64? bytesOut,
+    $fixnum.Int64? droppedPackets,
+    $core.int? tcpSessions,
+    $core.int? udpSessions,
+    $fixnum.Int64? uptimeSec,
+  }) {
+    final result = create();
+    if (packetsIn != null) result.packetsIn = packetsIn;
+    if (packetsOut != null) result.packetsOut = packetsOut;
+    if (bytesIn != null) result.bytesIn = bytesIn;
+    if (bytesOut != null) result.bytesOut = bytesOut;
+    if (droppedPackets != null) result.droppedPackets = droppedPackets;
+    if (tcpSessions != null) result.tcpSessions = tcpSessions;
+    if (udpSessions != null) result.udpSessions = udpSessions;
+    if (uptimeSec != null) result.uptimeSec = uptimeSec;
+    return result;
+  }
+
+  TunStatsResponse._();
+
+  factory TunStatsResponse.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory TunStatsResponse.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'TunStatsResponse', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..a<$fixnum.Int64>(1, _omitFieldNames ? '' : 'packetsIn', $pb.PbFieldType.OU6, protoName: 'packetsIn', defaultOrMaker: $fixnum.Int64.ZERO)
+    ..a<$fixnum.Int64>(2, _omitFieldNames ? '' : 'packetsOut', $pb.PbFieldType.OU6, protoName: 'packetsOut', defaultOrMaker: $fixnum.Int64.ZERO)
+    ..a<$fixnum.Int64>(3, _omitFieldNames ? '' : 'bytesIn', $pb.PbFieldType.OU6, protoName: 'bytesIn', defaultOrMaker: $fixnum.Int64.ZERO)
+    ..a<$fixnum.Int64>(4, _omitFieldNames ? '' : 'bytesOut', $pb.PbFieldType.OU6, protoName: 'bytesOut', defaultOrMaker: $fixnum.Int64.ZERO)
+    ..a<$fixnum.Int64>(5, _omitFieldNames ? '' : 'droppedPackets', $pb.PbFieldType.OU6, protoName: 'droppedPackets', defaultOrMaker: $fixnum.Int64.ZERO)
+    ..a<$core.int>(6, _omitFieldNames ? '' : 'tcpSessions', $pb.PbFieldType.O3, protoName: 'tcpSessions')
+    ..a<$core.int>(7, _omitFieldNames ? '' : 'udpSessions', $pb.PbFieldType.O3, protoName: 'udpSessions')
+    ..aInt64(8, _omitFieldNames ? '' : 'uptimeSec', protoName: 'uptimeSec')
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  TunStatsResponse clone() => TunStatsResponse()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  TunStatsResponse copyWith(void Function(TunStatsResponse) updates) => super.copyWith((message) => updates(message as TunStatsResponse)) as TunStatsResponse;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static TunStatsResponse create() => TunStatsResponse._();
+  @$core.override
+  TunStatsResponse createEmptyInstance() => create();
+  static $pb.PbList<TunStatsResponse> createRepeated() => $pb.PbList<TunStatsResponse>();
+  @$core.pragma('dart2js:noInline')
+  static TunStatsResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<TunStatsResponse>(create);
+  static TunStatsResponse? _defaultInstance;
+
+  /// From the OS into the tunnel
+  @$pb.TagNumber(1)
+  $fixnum.Int64 get packetsIn => $_getI64(0);
+  @$pb.TagNumber(1)
+  set packetsIn($fixnum.Int64 value) => $_setInt64(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasPacketsIn() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPacketsIn() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get packetsOut => $_getI64(1);
+  @$pb.TagNumber(2)
+  set packetsOut($fixnum.Int64 value) => $_setInt64(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasPacketsOut() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearPacketsOut() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get bytesIn => $_getI64(2);
+  @$pb.TagNumber(3)
+  set bytesIn($fixnum.Int64 value) => $_setInt64(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasBytesIn() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearBytesIn() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get bytesOut => $_getI64(3);
+  @$pb.TagNumber(4)
+  set bytesOut($fixnum.Int64 value) => $_setInt64(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasBytesOut() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearBytesOut() => $_clearField(4);
+
+  @$pb.TagNumber(5)
+  $fixnum.Int64 get droppedPackets => $_getI64(4);
+  @$pb.TagNumber(5)
+  set droppedPackets($fixnum.Int64 value) => $_setInt64(4, value);
+  @$pb.TagNumber(5)
+  $core.bool hasDroppedPackets() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearDroppedPackets() => $_clearField(5);
+
+  /// Currently relayed
+  @$pb.TagNumber(6)
+  $core.int get tcpSessions => $_getIZ(5);
+  @$pb.TagNumber(6)
+  set tcpSessions($core.int value) => $_setSignedInt32(5, value);
+  @$pb.TagNumber(6)
+  $core.bool hasTcpSessions() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearTcpSessions() => $_clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.int get udpSessions => $_getIZ(6);
+  @$pb.TagNumber(7)
+  set udpSessions($core.int value) => $_setSignedInt32(6, value);
+  @$pb.TagNumber(7)
+  $core.bool hasUdpSessions() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearUdpSessions() => $_clearField(7);
+
+  @$pb.TagNumber(8)
+  $fixnum.Int64 get uptimeSec => $_getI64(7);
+  @$pb.TagNumber(8)
+  set uptimeSec($fixnum.Int64 value) => $_setInt64(7, value);
+  @$pb.TagNumber(8)
+  $core.bool hasUptimeSec() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearUptimeSec() => $_clearField(8);
+}
+
+class ListConnectionsResponse extends $pb.GeneratedMessage {
+  factory ListConnectionsResponse({
+    $core.Iterable<Connection>? connections,
+  }) {
+    final result = create();
+    if (connections != null) result.connections.addAll(connections);
+    return result;
+  }
+
+  ListConnectionsResponse._();
+
+  factory ListConnectionsResponse.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory ListConnectionsResponse.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'ListConnectionsResponse', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..pc<Connection>(1, _omitFieldNames ? '' : 'connections', $pb.PbFieldType.PM, subBuilder: Connection.create)
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ListConnectionsResponse clone() => ListConnectionsResponse()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ListConnectionsResponse copyWith(void Function(ListConnectionsResponse) updates) => super.copyWith((message) => updates(message as ListConnectionsResponse)) as ListConnectionsResponse;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ListConnectionsResponse create() => ListConnectionsResponse._();
+  @$core.override
+  ListConnectionsResponse createEmptyInstance() => create();
+  static $pb.PbList<ListConnectionsResponse> createRepeated() => $pb.PbList<ListConnectionsResponse>();
+  @$core.pragma('dart2js:noInline')
+  static ListConnectionsResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ListConnectionsResponse>(create);
+  static ListConnectionsResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $pb.PbList<Connection> get connections => $_getList(0);
+}
+
+class Connection extends $pb.GeneratedMessage {
+  factory Connection({
+    $fixnum.Int64? id,
+    $core.String? coreName,
+    $core.String? network,
+    $core.String? source,
+    $core.String? destination,
+    $core.String? inboundTag,
+    $core.String? outboundTag,
+    $fixnum.Int64? startTime,
+    $fixnum.Int64? uplink,
+    $fixnum.Int64? downlink,
+  }) {
+    final result = create();
+    if (id != null) result.id = id;
+    if (coreName != null) result.coreName = coreName;
+    if (network != null) result.network = network;
+    if (source != null) result.source = source;
+    if (destination != null) result.destination = destination;
+    if (inboundTag != null) result.inboundTag = inboundTag;
+    if (outboundTag != null) result.outboundTag = outboundTag;
+    if (startTime != null) result.startTime = startTime;
+    if (uplink != null) result.uplink = uplink;
+    if (downlink != null) result.downlink = downlink;
+    return result;
+  }
+
+  Connection._();
+
+  factory Connection.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory Connection.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'Connection', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..a<$fixnum.Int64>(1, _omitFieldNames ? '' : 'id', $pb.PbFieldType.OU6, defaultOrMaker: $fixnum.Int64.ZERO)
+    ..aOS(2, _omitFieldNames ? '' : 'coreName', protoName: 'coreName')
+    ..aOS(3, _omitFieldNames ? '' : 'network')
+    ..aOS(4, _omitFieldNames ? '' : 'source')
+    ..aOS(5, _omitFieldNames ? '' : 'destination')
+    ..aOS(6, _omitFieldNames ? '' : 'inboundTag', protoName: 'inboundTag')
+    ..aOS(7, _omitFieldNames ? '' : 'outboundTag', protoName: 'outboundTag')
+    ..aInt64(8, _omitFieldNames ? '' : 'startTime', protoName: 'startTime')
+    ..aInt64(9, _omitFieldNames ? '' : 'uplink')
+    ..aInt64(10, _omitFieldNames ? '' : 'downlink')
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  Connection clone() => Connection()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  Connection copyWith(void Function(Connection) updates) => super.copyWith((message) => updates(message as Connection)) as Connection;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static Connection create() => Connection._();
+  @$core.override
+  Connection createEmptyInstance() => create();
+  static $pb.PbList<Connection> createRepeated() => $pb.PbList<Connection>();
+  @$core.pragma('dart2js:noInline')
+  static Connection getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<Connection>(create);
+  static Connection? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $fixnum.Int64 get id => $_getI64(0);
+  @$pb.TagNumber(1)
+  set id($fixnum.Int64 value) => $_setInt64(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasId() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearId() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get coreName => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set coreName($core.String value) => $_setString(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasCoreName() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearCoreName() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get network => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set network($core.String value) => $_setString(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasNetwork() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearNetwork() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.String get source => $_getSZ(3);
+  @$pb.TagNumber(4)
+  set source($core.String value) => $_setString(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasSource() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearSource() => $_clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.String get destination => $_getSZ(4);
+  @$pb.TagNumber(5)
+  set destination($core.String value) => $_setString(4, value);
+  @$pb.TagNumber(5)
+  $core.bool hasDestination() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearDestination() => $_clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.String get inboundTag => $_getSZ(5);
+  @$pb.TagNumber(6)
+  set inboundTag($core.String value) => $_setString(5, value);
+  @$pb.TagNumber(6)
+  $core.bool hasInboundTag() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearInboundTag() => $_clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.String get outboundTag => $_getSZ(6);
+  @$pb.TagNumber(7)
+  set outboundTag($core.String value) => $_setString(6, value);
+  @$pb.TagNumber(7)
+  $core.bool hasOutboundTag() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearOutboundTag() => $_clearField(7);
+
+  @$pb.TagNumber(8)
+  $fixnum.Int64 get startTime => $_getI64(7);
+  @$pb.TagNumber(8)
+  set startTime($fixnum.Int64 value) => $_setInt64(7, value);
+  @$pb.TagNumber(8)
+  $core.bool hasStartTime() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearStartTime() => $_clearField(8);
+
+  @$pb.TagNumber(9)
+  $fixnum.Int64 get uplink => $_getI64(8);
+  @$pb.TagNumber(9)
+  set uplink($fixnum.Int64 value) => $_setInt64(8, value);
+  @$pb.TagNumber(9)
+  $core.bool hasUplink() => $_has(8);
+  @$pb.TagNumber(9)
+  void clearUplink() => $_clearField(9);
+
+  @$pb.TagNumber(10)
+  $fixnum.Int64 get downlink => $_getI64(9);
+  @$pb.TagNumber(10)
+  set downlink($fixnum.Int64 value) => $_setInt64(9, value);
+  @$pb.TagNumber(10)
+  $core.bool hasDownlink() => $_has(9);
+  @$pb.TagNumber(10)
+  void clearDownlink() => $_clearField(10);
+}
+
+class ConvertShareLinkResponse extends $pb.GeneratedMessage {
+  factory ConvertShareLinkResponse({
+    $core.String? config,
+    $core.String? name,
+  }) {
+    final result = create();
+    if (config != null) result.config = config;
+    if (name != null) result.name = name;
+    return result;
+  }
+
+  ConvertShareLinkResponse._();
+
+  factory ConvertShareLinkResponse.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory ConvertShareLinkResponse.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'ConvertShareLinkResponse', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'config')
+    ..aOS(2, _omitFieldNames ? '' : 'name')
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ConvertShareLinkResponse clone() => ConvertShareLinkResponse()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ConvertShareLinkResponse copyWith(void Function(ConvertShareLinkResponse) updates) => super.copyWith((message) => updates(message as ConvertShareLinkResponse)) as ConvertShareLinkResponse;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ConvertShareLinkResponse create() => ConvertShareLinkResponse._();
+  @$core.override
+  ConvertShareLinkResponse createEmptyInstance() => create();
+  static $pb.PbList<ConvertShareLinkResponse> createRepeated() => $pb.PbList<ConvertShareLinkResponse>();
+  @$core.pragma('dart2js:noInline')
+  static ConvertShareLinkResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ConvertShareLinkResponse>(create);
+  static ConvertShareLinkResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get config => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set config($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasConfig() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearConfig() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get name => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set name($core.String value) => $_setString(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasName() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearName() => $_clearField(2);
+}
+
+class FetchSubscriptionResponse extends $pb.GeneratedMessage {
+  factory FetchSubscriptionResponse({
+    $core.String? title,
+    $core.Iterable<SubscriptionEntry>? entries,
+    SubscriptionUserinfo? userinfo,
+    $core.int? skipped,
+  }) {
+    final result = create();
+    if (title != null) result.title = title;
+    if (entries != null) result.entries.addAll(entries);
+    if (userinfo != null) result.userinfo = userinfo;
+    if (skipped != null) result.skipped = skipped;
+    return result;
+  }
+
+  FetchSubscriptionResponse._();
+
+  factory FetchSubscriptionResponse.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory FetchSubscriptionResponse.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'FetchSubscriptionResponse', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'title')
+    ..pc<SubscriptionEntry>(2, _omitFieldNames ? '' : 'entries', $pb.PbFieldType.PM, subBuilder: SubscriptionEntry.create)
+    ..aOM<SubscriptionUserinfo>(3, _omitFieldNames ? '' : 'userinfo', subBuilder: SubscriptionUserinfo.create)
+    ..a<$core.int>(4, _omitFieldNames ? '' : 'skipped', $pb.PbFieldType.O3)
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  FetchSubscriptionResponse clone() => FetchSubscriptionResponse()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  FetchSubscriptionResponse copyWith(void Function(FetchSubscriptionResponse) updates) => super.copyWith((message) => updates(message as FetchSubscriptionResponse)) as FetchSubscriptionResponse;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static FetchSubscriptionResponse create() => FetchSubscriptionResponse._();
+  @$core.override
+  FetchSubscriptionResponse createEmptyInstance() => create();
+  static $pb.PbList<FetchSubscriptionResponse> createRepeated() => $pb.PbList<FetchSubscriptionResponse>();
+  @$core.pragma('dart2js:noInline')
+  static FetchSubscriptionResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<FetchSubscriptionResponse>(create);
+  static FetchSubscriptionResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get title => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set title($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasTitle() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearTitle() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $pb.PbList<SubscriptionEntry> get entries => $_getList(1);
+
+  /// Unset when the provider sent no Subscription-Userinfo
+  @$pb.TagNumber(3)
+  SubscriptionUserinfo get userinfo => $_getN(2);
+  @$pb.TagNumber(3)
+  set userinfo(SubscriptionUserinfo value) => $_setField(3, value);
+  @$pb.TagNumber(3)
+  $core.bool hasUserinfo() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearUserinfo() => $_clearField(3);
+  @$pb.TagNumber(3)
+  SubscriptionUserinfo ensureUserinfo() => $_ensure(2);
+
+  @$pb.TagNumber(4)
+  $core.int get skipped => $_getIZ(3);
+  @$pb.TagNumber(4)
+  set skipped($core.int value) => $_setSignedInt32(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasSkipped() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearSkipped() => $_clearField(4);
+}
+
+class SubscriptionEntry extends $pb.GeneratedMessage {
+  factory SubscriptionEntry({
+    $core.String? name,
+    $core.String? remark,
+    $core.String? protocol,
+    $core.String? address,
+    $core.int? port,
+    $core.String? link,
+  }) {
+    final result = create();
+    if (name != null) result.name = name;
+    if (remark != null) result.remark = remark;
+    if (protocol != null) result.protocol = protocol;
+    if (address != null) result.address = address;
+    if (port != null) result.port = port;
+    if (link != null) result.link = link;
+    return result;
+  }
+
+  SubscriptionEntry._();
+
+  factory SubscriptionEntry.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory SubscriptionEntry.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'SubscriptionEntry', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'name')
+    ..aOS(2, _omitFieldNames ? '' : 'remark')
+    ..aOS(3, _omitFieldNames ? '' : 'protocol')
+    ..aOS(4, _omitFieldNames ? '' : 'address')
+    ..a<$core.int>(5, _omitFieldNames ? '' : 'port', $pb.PbFieldType.O3)
+    ..aOS(6, _omitFieldNames ? '' : 'link')
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  SubscriptionEntry clone() => SubscriptionEntry()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  SubscriptionEntry copyWith(void Function(SubscriptionEntry) updates) => super.copyWith((message) => updates(message as SubscriptionEntry)) as SubscriptionEntry;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static SubscriptionEntry create() => SubscriptionEntry._();
+  @$core.override
+  SubscriptionEntry createEmptyInstance() => create();
+  static $pb.PbList<SubscriptionEntry> createRepeated() => $pb.PbList<SubscriptionEntry>();
+  @$core.pragma('dart2js:noInline')
+  static SubscriptionEntry getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SubscriptionEntry>(create);
+  static SubscriptionEntry? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get name => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set name($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasName() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearName() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get remark => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set remark($core.String value) => $_setString(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasRemark() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearRemark() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get protocol => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set protocol($core.String value) => $_setString(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasProtocol() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearProtocol() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.String get address => $_getSZ(3);
+  @$pb.TagNumber(4)
+  set address($core.String value) => $_setString(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasAddress() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearAddress() => $_clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.int get port => $_getIZ(4);
+  @$pb.TagNumber(5)
+  set port($core.int value) => $_setSignedInt32(4, value);
+  @$pb.TagNumber(5)
+  $core.bool hasPort() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearPort() => $_clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.String get link => $_getSZ(5);
+  @$pb.TagNumber(6)
+  set link($core.String value) => $_setString(5, value);
+  @$pb.TagNumber(6)
+  $core.bool hasLink() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearLink() => $_clearField(6);
+}
+
+class SubscriptionUserinfo extends $pb.GeneratedMessage {
+  factory SubscriptionUserinfo({
+    $fixnum.Int64? upload,
+    $fixnum.Int64? download,
+    $fixnum.Int64? total,
+    $fixnum.Int64? expire,
+  }) {
+    final result = create();
+    if (upload != null) result.upload = upload;
+    if (download != null) result.download = download;
+    if (total != null) result.total = total;
+    if (expire != null) result.expire = expire;
+    return result;
+  }
+
+  SubscriptionUserinfo._();
+
+  factory SubscriptionUserinfo.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory SubscriptionUserinfo.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'SubscriptionUserinfo', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aInt64(1, _omitFieldNames ? '' : 'upload')
+    ..aInt64(2, _omitFieldNames ? '' : 'download')
+    ..aInt64(3, _omitFieldNames ? '' : 'total')
+    ..aInt64(4, _omitFieldNames ? '' : 'expire')
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  SubscriptionUserinfo clone() => SubscriptionUserinfo()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  SubscriptionUserinfo copyWith(void Function(SubscriptionUserinfo) updates) => super.copyWith((message) => updates(message as SubscriptionUserinfo)) as SubscriptionUserinfo;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static SubscriptionUserinfo create() => SubscriptionUserinfo._();
+  @$core.override
+  SubscriptionUserinfo createEmptyInstance() => create();
+  static $pb.PbList<SubscriptionUserinfo> createRepeated() => $pb.PbList<SubscriptionUserinfo>();
+  @$core.pragma('dart2js:noInline')
+  static SubscriptionUserinfo getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SubscriptionUserinfo>(create);
+  static SubscriptionUserinfo? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $fixnum.Int64 get upload => $_getI64(0);
+  @$pb.TagNumber(1)
+  set upload($fixnum.Int64 value) => $_setInt64(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasUpload() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearUpload() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get download => $_getI64(1);
+  @$pb.TagNumber(2)
+  set download($fixnum.Int64 value) => $_setInt64(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasDownload() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearDownload() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get total => $_getI64(2);
+  @$pb.TagNumber(3)
+  set total($fixnum.Int64 value) => $_setInt64(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasTotal() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearTotal() => $_clearField(3);
+
+  /// Unix millis, 0 when it never expires
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get expire => $_getI64(3);
+  @$pb.TagNumber(4)
+  set expire($fixnum.Int64 value) => $_setInt64(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasExpire() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearExpire() => $_clearField(4);
+}
+
+class TestConfigResult extends $pb.GeneratedMessage {
+  factory TestConfigResult({
+    $core.String? id,
+    $core.int? index,
+    $core.String? coreName,
+    $fixnum.Int64? connectDelay,
+    $fixnum.Int64? tlsDelay,
+    $fixnum.Int64? httpDelay,
+    $fixnum.Int64? delay,
+    $core.int? statusCode,
+    $core.String? error,
+    PingErrorCategory? errorCategory,
+  }) {
+    final result = create();
+    if (id != null) result.id = id;
+    if (index != null) result.index = index;
+    if (coreName != null) result.coreName = coreName;
+    if (connectDelay != null) result.connectDelay = connectDelay;
+    if (tlsDelay != null) result.tlsDelay = tlsDelay;
+    if (httpDelay != null) result.httpDelay = httpDelay;
+    if (delay != null) result.delay = delay;
+    if (statusCode != null) result.statusCode = statusCode;
+    if (error != null) result.error = error;
+    if (errorCategory != null) result.errorCategory = errorCategory;
+    return result;
+  }
+
+  TestConfigResult._();
+
+  factory TestConfigResult.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory TestConfigResult.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'TestConfigResult', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'id')
+    ..a<$core.int>(2, _omitFieldNames ? '' : 'index', $pb.PbFieldType.O3)
+    ..aOS(3, _omitFieldNames ? '' : 'coreName', protoName: 'coreName')
+    ..aInt64(4, _omitFieldNames ? '' : 'connectDelay', protoName: 'connectDelay')
+    ..aInt64(5, _omitFieldNames ? '' : 'tlsDelay', protoName: 'tlsDelay')
+    ..aInt64(6, _omitFieldNames ? '' : 'httpDelay', protoName: 'httpDelay')
+    ..aInt64(7, _omitFieldNames ? '' : 'delay')
+    ..a<$core.int>(8, _omitFieldNames ? '' : 'statusCode', $pb.PbFieldType.O3, protoName: 'statusCode')
+    ..aOS(9, _omitFieldNames ? '' : 'error')
+    ..e<PingErrorCategory>(10, _omitFieldNames ? '' : 'errorCategory', $pb.PbFieldType.OE, protoName: 'errorCategory', defaultOrMaker: PingErrorCategory.PING_ERROR_NONE, valueOf: PingErrorCategory.valueOf, enumValues: PingErrorCategory.values)
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  TestConfigResult clone() => TestConfigResult()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  TestConfigResult copyWith(void Function(TestConfigResult) updates) => super.copyWith((message) => updates(message as TestConfigResult)) as TestConfigResult;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static TestConfigResult create() => TestConfigResult._();
+  @$core.override
+  TestConfigResult createEmptyInstance() => create();
+  static $pb.PbList<TestConfigResult> createRepeated() => $pb.PbList<TestConfigResult>();
+  @$core.pragma('dart2js:noInline')
+  static TestConfigResult getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<TestConfigResult>(create);
+  static TestConfigResult? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get id => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set id($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasId() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearId() => $_clearField(1);
+
+  /// Position in TestConfigsRequest.configs
+  @$pb.TagNumber(2)
+  $core.int get index => $_getIZ(1);
+  @$pb.TagNumber(2)
+  set index($core.int value) => $_setSignedInt32(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasIndex() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearIndex() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get coreName => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set coreName($core.String value) => $_setString(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasCoreName() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearCoreName() => $_clearField(3);
+
+  /// Milliseconds, phases that did not run are 0
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get connectDelay => $_getI64(3);
+  @$pb.TagNumber(4)
+  set connectDelay($fixnum.Int64 value) => $_setInt64(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasConnectDelay() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearConnectDelay() => $_clearField(4);
+
+  @$pb.TagNumber(5)
+  $fixnum.Int64 get tlsDelay => $_getI64(4);
+  @$pb.TagNumber(5)
+  set tlsDelay($fixnum.Int64 value) => $_setInt64(4, value);
+  @$pb.TagNumber(5)
+  $core.bool hasTlsDelay() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearTlsDelay() => $_clearField(5);
+
+  @$pb.TagNumber(6)
+  $fixnum.Int64 get httpDelay => $_getI64(5);
+  @$pb.TagNumber(6)
+  set httpDelay($fixnum.Int64 value) => $_setInt64(5, value);
+  @$pb.TagNumber(6)
+  $core.bool hasHttpDelay() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearHttpDelay() => $_clearField(6);
+
+  /// Whole request, -1 on failure
+  @$pb.TagNumber(7)
+  $fixnum.Int64 get delay => $_getI64(6);
+  @$pb.TagNumber(7)
+  set delay($fixnum.Int64 value) => $_setInt64(6, value);
+  @$pb.TagNumber(7)
+  $core.bool hasDelay() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearDelay() => $_clearField(7);
+
+  @$pb.TagNumber(8)
+  $core.int get statusCode => $_getIZ(7);
+  @$pb.TagNumber(8)
+  set statusCode($core.int value) => $_setSignedInt32(7, value);
+  @$pb.TagNumber(8)
+  $core.bool hasStatusCode() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearStatusCode() => $_clearField(8);
+
+  @$pb.TagNumber(9)
+  $core.String get error => $_getSZ(8);
+  @$pb.TagNumber(9)
+  set error($core.String value) => $_setString(8, value);
+  @$pb.TagNumber(9)
+  $core.bool hasError() => $_has(8);
+  @$pb.TagNumber(9)
+  void clearError() => $_clearField(9);
+
+  @$pb.TagNumber(10)
+  PingErrorCategory get errorCategory => $_getN(9);
+  @$pb.TagNumber(10)
+  set errorCategory(PingErrorCategory value) => $_setField(10, value);
+  @$pb.TagNumber(10)
+  $core.bool hasErrorCategory() => $_has(9);
+  @$pb.TagNumber(10)
+  void clearErrorCategory() => $_clearField(10);
+}
+
+class OutboundStatusResponse extends $pb.GeneratedMessage {
+  factory OutboundStatusResponse({
+    $core.String? selected,
+    $core.Iterable<OutboundHealth>? outbounds,
+  }) {
+    final result = create();
+    if (selected != null) result.selected = selected;
+    if (outbounds != null) result.outbounds.addAll(outbounds);
+    return result;
+  }
+
+  OutboundStatusResponse._();
+
+  factory OutboundStatusResponse.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory OutboundStatusResponse.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'OutboundStatusResponse', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'selected')
+    ..pc<OutboundHealth>(2, _omitFieldNames ? '' : 'outbounds', $pb.PbFieldType.PM, subBuilder: OutboundHealth.create)
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  OutboundStatusResponse clone() => OutboundStatusResponse()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  OutboundStatusResponse copyWith(void Function(OutboundStatusResponse) updates) => super.copyWith((message) => updates(message as OutboundStatusResponse)) as OutboundStatusResponse;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static OutboundStatusResponse create() => OutboundStatusResponse._();
+  @$core.override
+  OutboundStatusResponse createEmptyInstance() => create();
+  static $pb.PbList<OutboundStatusResponse> createRepeated() => $pb.PbList<OutboundStatusResponse>();
+  @$core.pragma('dart2js:noInline')
+  static OutboundStatusResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<OutboundStatusResponse>(create);
+  static OutboundStatusResponse? _defaultInstance;
+
+  /// Outbound tag the balancer currently picks, empty outside pool mode
+  @$pb.TagNumber(1)
+  $core.String get selected => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set selected($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasSelected() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearSelected() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $pb.PbList<OutboundHealth> get outbounds => $_getList(1);
+}
+
+class OutboundHealth extends $pb.GeneratedMessage {
+  factory OutboundHealth({
+    $core.String? tag,
+    $core.String? name,
+    $core.bool? alive,
+    $fixnum.Int64? delay,
+    $core.String? error,
+    $fixnum.Int64? lastSeenTime,
+    $fixnum.Int64? lastTryTime,
+  }) {
+    final result = create();
+    if (tag != null) result.tag = tag;
+    if (name != null) result.name = name;
+    if (alive != null) result.alive = alive;
+    if (delay != null) result.delay = delay;
+    if (error != null) result.error = error;
+    if (lastSeenTime != null) result.lastSeenTime = lastSeenTime;
+    if (lastTryTime != null) result.lastTryTime = lastTryTime;
+    return result;
+  }
+
+  OutboundHealth._();
+
+  factory OutboundHealth.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory OutboundHealth.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'OutboundHealth', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'tag')
+    ..aOS(2, _omitFieldNames ? '' : 'name')
+    ..aOB(3, _omitFieldNames ? '' : 'alive')
+    ..aInt64(4, _omitFieldNames ? '' : 'delay')
+    ..aOS(5, _omitFieldNames ? '' : 'error')
+    ..aInt64(6, _omitFieldNames ? '' : 'lastSeenTime', protoName: 'lastSeenTime')
+    ..aInt64(7, _omitFieldNames ? '' : 'lastTryTime', protoName: 'lastTryTime')
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  OutboundHealth clone() => OutboundHealth()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  OutboundHealth copyWith(void Function(OutboundHealth) updates) => super.copyWith((message) => updates(message as OutboundHealth)) as OutboundHealth;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static OutboundHealth create() => OutboundHealth._();
+  @$core.override
+  OutboundHealth createEmptyInstance() => create();
+  static $pb.PbList<OutboundHealth> createRepeated() => $pb.PbList<OutboundHealth>();
+  @$core.pragma('dart2js:noInline')
+  static OutboundHealth getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<OutboundHealth>(create);
+  static OutboundHealth? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get tag => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set tag($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasTag() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearTag() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get name => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set name($core.String value) => $_setString(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasName() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearName() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.bool get alive => $_getBF(2);
+  @$pb.TagNumber(3)
+  set alive($core.bool value) => $_setBool(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasAlive() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearAlive() => $_clearField(3);
+
+  /// Last probe in milliseconds, -1 when not alive
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get delay => $_getI64(3);
+  @$pb.TagNumber(4)
+  set delay($fixnum.Int64 value) => $_setInt64(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasDelay() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearDelay() => $_clearField(4);
+
+  /// Last failure reason
+  @$pb.TagNumber(5)
+  $core.String get error => $_getSZ(4);
+  @$pb.TagNumber(5)
+  set error($core.String value) => $_setString(4, value);
+  @$pb.TagNumber(5)
+  $core.bool hasError() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearError() => $_clearField(5);
+
+  /// Unix millis of the last successful probe
+  @$pb.TagNumber(6)
+  $fixnum.Int64 get lastSeenTime => $_getI64(5);
+  @$pb.TagNumber(6)
+  set lastSeenTime($fixnum.Int64 value) => $_setInt64(5, value);
+  @$pb.TagNumber(6)
+  $core.bool hasLastSeenTime() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearLastSeenTime() => $_clearField(6);
+
+  /// Unix millis of the last probe
+  @$pb.TagNumber(7)
+  $fixnum.Int64 get lastTryTime => $_getI64(6);
+  @$pb.TagNumber(7)
+  set lastTryTime($fixnum.Int64 value) => $_setInt64(6, value);
+  @$pb.TagNumber(7)
+  $core.bool hasLastTryTime() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearLastTryTime() => $_clearField(7);
+}
+
+class LogEntry extends $pb.GeneratedMessage {
+  factory LogEntry({
+    $fixnum.Int64? seq,
+    $fixnum.Int64? timestamp,
+    $core.String? level,
+    $core.String? coreName,
+    $core.String? message,
+  }) {
+    final result = create();
+    if (seq != null) result.seq = seq;
+    if (timestamp != null) result.timestamp = timestamp;
+    if (level != null) result.level = level;
+    if (coreName != null) result.coreName = coreName;
+    if (message != null) result.message = message;
+    return result;
+  }
+
+  LogEntry._();
+
+  factory LogEntry.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory LogEntry.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'LogEntry', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..a<$fixnum.Int64>(1, _omitFieldNames ? '' : 'seq', $pb.PbFieldType.OU6, defaultOrMaker: $fixnum.Int64.ZERO)
+    ..aInt64(2, _omitFieldNames ? '' : 'timestamp')
+    ..aOS(3, _omitFieldNames ? '' : 'level')
+    ..aOS(4, _omitFieldNames ? '' : 'coreName', protoName: 'coreName')
+    ..aOS(5, _omitFieldNames ? '' : 'message')
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  LogEntry clone() => LogEntry()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  LogEntry copyWith(void Function(LogEntry) updates) => super.copyWith((message) => updates(message as LogEntry)) as LogEntry;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static LogEntry create() => LogEntry._();
+  @$core.override
+  LogEntry createEmptyInstance() => create();
+  static $pb.PbList<LogEntry> createRepeated() => $pb.PbList<LogEntry>();
+  @$core.pragma('dart2js:noInline')
+  static LogEntry getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<LogEntry>(create);
+  static LogEntry? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $fixnum.Int64 get seq => $_getI64(0);
+  @$pb.TagNumber(1)
+  set seq($fixnum.Int64 value) => $_setInt64(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasSeq() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearSeq() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get timestamp => $_getI64(1);
+  @$pb.TagNumber(2)
+  set timestamp($fixnum.Int64 value) => $_setInt64(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasTimestamp() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearTimestamp() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get level => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set level($core.String value) => $_setString(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasLevel() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearLevel() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.String get coreName => $_getSZ(3);
+  @$pb.TagNumber(4)
+  set coreName($core.String value) => $_setString(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasCoreName() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearCoreName() => $_clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.String get message => $_getSZ(4);
+  @$pb.TagNumber(5)
+  set message($core.String value) => $_setString(4, value);
+  @$pb.TagNumber(5)
+  $core.bool hasMessage() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearMessage() => $_clearField(5);
+}
+
+class CoreStateEvent extends $pb.GeneratedMessage {
+  factory CoreStateEvent({
+    $core.String? component,
+    CoreState? state,
+    $core.String? error,
+    $fixnum.Int64? timestamp,
+  }) {
+    final result = create();
+    if (component != null) result.component = component;
+    if (state != null) result.state = state;
+    if (error != null) result.error = error;
+    if (timestamp != null) result.timestamp = timestamp;
+    return result;
+  }
+
+  CoreStateEvent._();
+
+  factory CoreStateEvent.fromBuffer($core.List<$core.int> data, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(data, registry);
+  factory CoreStateEvent.fromJson($core.String json, [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(_omitMessageNames ? '' : 'CoreStateEvent', package: const $pb.PackageName(_omitMessageNames ? '' : 'ProxyCore'), createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'component')
+    ..e<CoreState>(2, _omitFieldNames ? '' : 'state', $pb.PbFieldType.OE, defaultOrMaker: CoreState.CORE_STATE_STOPPED, valueOf: CoreState.valueOf, enumValues: CoreState.values)
+    ..aOS(3, _omitFieldNames ? '' : 'error')
+    ..aInt64(4, _omitFieldNames ? '' : 'timestamp')
+    ..hasRequiredFields = false
+  ;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  CoreStateEvent clone() => CoreStateEvent()..mergeFromMessage(this);
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  CoreStateEvent copyWith(void Function(CoreStateEvent) updates) => super.copyWith((message) => updates(message as CoreStateEvent)) as CoreStateEvent;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static CoreStateEvent create() => CoreStateEvent._();
+  @$core.override
+  CoreStateEvent createEmptyInstance() => create();
+  static $pb.PbList<CoreStateEvent> createRepeated() => $pb.PbList<CoreStateEvent>();
+  @$core.pragma('dart2js:noInline')
+  static CoreStateEvent getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CoreStateEvent>(create);
+  static CoreStateEvent? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get component => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set component($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasComponent() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearComponent() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  CoreState get state => $_getN(1);
+  @$pb.TagNumber(2)
+  set state(CoreState value) => $_setField(2, value);
+  @$pb.TagNumber(2)
+  $core.bool hasState() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearState() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get error => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set error($core.String value) => $_setString(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasError() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearError() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get timestamp => $_getI64(3);
+  @$pb.TagNumber(4)
+  set timestamp($fixnum.Int64 value) => $_setInt64(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasTimestamp() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearTimestamp() => $_clearField(4);
 }
 
 class Empty extends $pb.GeneratedMessage {
@@ -10,3 +10,55 @@
 // ignore_for_file: deprecated_member_use_from_same_package, library_prefixes
 // ignore_for_file: non_constant_identifier_names
 
+import 'dart:core' as $core;
+
+import 'package:protobuf/protobuf.dart' as $pb;
+
+class PingErrorCategory extends $pb.ProtobufEnum {
+  static const PingErrorCategory PING_ERROR_NONE = PingErrorCategory._(0, _omitEnumNames ? '' : 'PING_ERROR_NONE');
+  static const PingErrorCategory PING_ERROR_TIMEOUT = PingErrorCategory._(1, _omitEnumNames ? '' : 'PING_ERROR_TIMEOUT');
+  static const PingErrorCategory PING_ERROR_REFUSED = PingErrorCategory._(2, _omitEnumNames ? '' : 'PING_ERROR_REFUSED');
+  static const PingErrorCategory PING_ERROR_TLS = PingErrorCategory._(3, _omitEnumNames ? '' : 'PING_ERROR_TLS');
+  static const PingErrorCategory PING_ERROR_BAD_STATUS = PingErrorCategory._(4, _omitEnumNames ? '' : 'PING_ERROR_BAD_STATUS');
+  static const PingErrorCategory PING_ERROR_DNS = PingErrorCategory._(5, _omitEnumNames ? '' : 'PING_ERROR_DNS');
+  static const PingErrorCategory PING_ERROR_OTHER = PingErrorCategory._(6, _omitEnumNames ? '' : 'PING_ERROR_OTHER');
+
+  static const $core.List<PingErrorCategory> values = <PingErrorCategory> [
+    PING_ERROR_NONE,
+    PING_ERROR_TIMEOUT,
+    PING_ERROR_REFUSED,
+    PING_ERROR_TLS,
+    PING_ERROR_BAD_STATUS,
+    PING_ERROR_DNS,
+    PING_ERROR_OTHER,
+  ];
+
+  static final $core.List<PingErrorCategory?> _byValue = $pb.ProtobufEnum.$_initByValueList(values, 6);
+  static PingErrorCategory? valueOf($core.int value) =>  value < 0 || value >= _byValue.length ? null : _byValue[value];
+
+  const PingErrorCategory._(super.value, super.name);
+}
+
+class CoreState extends $pb.ProtobufEnum {
+  static const CoreState CORE_STATE_STOPPED = CoreState._(0, _omitEnumNames ? '' : 'CORE_STATE_STOPPED');
+  static const CoreState CORE_STATE_STARTING = CoreState._(1, _omitEnumNames ? '' : 'CORE_STATE_STARTING');
+  static const CoreState CORE_STATE_RUNNING = CoreState._(2, _omitEnumNames ? '' : 'CORE_STATE_RUNNING');
+  static const CoreState CORE_STATE_STOPPING = CoreState._(3, _omitEnumNames ? '' : 'CORE_STATE_STOPPING');
+  static const CoreState CORE_STATE_FAILED = CoreState._(4, _omitEnumNames ? '' : 'CORE_STATE_FAILED');
+
+  static const $core.List<CoreState> values = <CoreState> [
+    CORE_STATE_STOPPED,
+    CORE_STATE_STARTING,
+    CORE_STATE_RUNNING,
+    CORE_STATE_STOPPING,
+    CORE_STATE_FAILED,
+  ];
+
+  static final $core.List<CoreState?> _byValue = $pb.ProtobufEnum.$_initByValueList(values, 4);
+  static CoreState? valueOf($core.int value) =>  value < 0 || value >= _byValue.length ? null : _byValue[value];
+
+  const CoreState._(super.value, super.name);
+}
+
+
+const $core.bool _omitEnumNames = $core.bool.fromEnvironment('protobuf.omit_enum_names');
@@ -61,6 +61,50 @@ class ProxyCoreClient extends $grpc.Client {
     return $createUnaryCall(_$measurePing, request, options: options);
   }
 
+  $grpc.ResponseStream<$0.LogEntry> streamLogs($0.StreamLogsRequest request, {$grpc.CallOptions? options,}) {
+    return $createStreamingCall(_$streamLogs, $async.Stream.fromIterable([request]), options: options);
+  }
+
+  $grpc.ResponseStream<$0.CoreStateEvent> watchCoreState($0.Empty request, {$grpc.CallOptions? options,}) {
+    return $createStreamingCall(_$watchCoreState, $async.Stream.fromIterable([request]), options: options);
+  }
+
+  $grpc.ResponseFuture<$0.TrafficStatsResponse> getTrafficStats($0.TrafficStatsRequest request, {$grpc.CallOptions? options,}) {
+    return $createUnaryCall(_$getTrafficStats, request, options: options);
+  }
+
+  $grpc.ResponseFuture<$0.ListConnectionsResponse> listConnections($0.Empty request, {$grpc.CallOptions? options,}) {
+    return $createUnaryCall(_$listConnections, request, options: options);
+  }
+
+  $grpc.ResponseFuture<$0.Empty> closeConnection($0.CloseConnectionRequest request, {$grpc.CallOptions? options,}) {
+    return $createUnaryCall(_$closeConnection, request, options: options);
+  }
+
+  $grpc.ResponseFuture<$0.Empty> reloadCore($0.StartCoreRequest request, {$grpc.CallOptions? options,}) {
+    return $createUnaryCall(_$reloadCore, request, options: options);
+  }
+
+  $grpc.ResponseFuture<$0.ConvertShareLinkResponse> convertShareLink($0.ConvertShareLinkRequest request, {$grpc.CallOptions? options,}) {
+    return $createUnaryCall(_$convertShareLink, request, options: options);
+  }
+
+  $grpc.ResponseFuture<$0.FetchSubscriptionResponse> fetchSubscription($0.FetchSubscriptionRequest request, {$grpc.CallOptions? options,}) {
+    return $createUnaryCall(_$fetchSubscription, request, options: options);
+  }
+
+  $grpc.ResponseStream<$0.TestConfigResult> testConfigs($0.TestConfigsRequest request, {$grpc.CallOptions? options,}) {
+    return $createStreamingCall(_$testConfigs, $async.Stream.fromIterable([request]), options: options);
+  }
+
+  $grpc.ResponseFuture<$0.OutboundStatusResponse> getOutboundStatus($0.Empty request, {$grpc.CallOptions? options,}) {
+    return $createUnaryCall(_$getOutboundStatus, request, options: options);
+  }
+
+  $grpc.ResponseFuture<$0.TunStatsResponse> getTunStats($0.Empty request, {$grpc.CallOptions? options,}) {
+    return $createUnaryCall(_$getTunStats, request, options: options);
+  }
+
     // method descriptors
 
   static final _$startCore = $grpc.ClientMethod<$0.StartCoreRequest, $0.Empty>(
@@ -91,6 +135,50 @@ class ProxyCoreClient extends $grpc.Client {
       '/ProxyCore.ProxyCore/measurePing',
       ($0.MeasurePingRequest value) => value.writeToBuffer(),
       $0.MeasurePingResponse.fromBuffer);
+  static final _$streamLogs = $grpc.ClientMethod<$0.StreamLogsRequest, $0.LogEntry>(
+      '/ProxyCore.ProxyCore/streamLogs',
+      ($0.StreamLogsRequest value) => value.writeToBuffer(),
+      $0.LogEntry.fromBuffer);
+  static final _$watchCoreState = $grpc.ClientMethod<$0.Empty, $0.CoreStateEvent>(
+      '/ProxyCore.ProxyCore/watchCoreState',
+      ($0.Empty value) => value.writeToBuffer(),
+      $0.CoreStateEvent.fromBuffer);
+  static final _$getTrafficStats = $grpc.ClientMethod<$0.TrafficStatsRequest, $0.TrafficStatsResponse>(
+      '/ProxyCore.ProxyCore/getTrafficStats',
+      ($0.TrafficStatsRequest value) => value.writeToBuffer(),
+      $0.TrafficStatsResponse.fromBuffer);
+  static final _$listConnections = $grpc.ClientMethod<$0.Empty, $0.ListConnectionsResponse>(
+      '/ProxyCore.ProxyCore/listConnections',
+      ($0.Empty value) => value.writeToBuffer(),
+      $0.ListConnectionsResponse.fromBuffer);
+  static final _$closeConnection = $grpc.ClientMethod<$0.CloseConnectionRequest, $0.Empty>(
+      '/ProxyCore.ProxyCore/closeConnection',
+      ($0.CloseConnectionRequest value) => value.writeToBuffer(),
+      $0.Empty.fromBuffer);
+  static final _$reloadCore = $grpc.ClientMethod<$0.StartCoreRequest, $0.Empty>(
+      '/ProxyCore.ProxyCore/reloadCore',
+      ($0.StartCoreRequest value) => value.writeToBuffer(),
+      $0.Empty.fromBuffer);
+  static final _$convertShareLink = $grpc.ClientMethod<$0.ConvertShareLinkRequest, $0.ConvertShareLinkResponse>(
+      '/ProxyCore.ProxyCore/convertShareLink',
+      ($0.ConvertShareLinkRequest value) => value.writeToBuffer(),
+      $0.ConvertShareLinkResponse.fromBuffer);
+  static final _$fetchSubscription = $grpc.ClientMethod<$0.FetchSubscriptionRequest, $0.FetchSubscriptionResponse>(
+      '/ProxyCore.ProxyCore/fetchSubscription',
+      ($0.FetchSubscriptionRequest value) => value.writeToBuffer(),
+      $0.FetchSubscriptionResponse.fromBuffer);
+  static final _$testConfigs = $grpc.ClientMethod<$0.TestConfigsRequest, $0.TestConfigResult>(
+      '/ProxyCore.ProxyCore/testConfigs',
+      ($0.TestConfigsRequest value) => value.writeToBuffer(),
+      $0.TestConfigResult.fromBuffer);
+  static final _$getOutboundStatus = $grpc.ClientMethod<$0.Empty, $0.OutboundStatusResponse>(
+      '/ProxyCore.ProxyCore/getOutboundStatus',
+      ($0.Empty value) => value.writeToBuffer(),
+      $0.OutboundStatusResponse.fromBuffer);
+  static final _$getTunStats = $grpc.ClientMethod<$0.Empty, $0.TunStatsResponse>(
+      '/ProxyCore.ProxyCore/getTunStats',
+      ($0.Empty value) => value.writeToBuffer(),
+      $0.TunStatsResponse.fromBuffer);
 }
 
 @$pb.GrpcServiceName('ProxyCore.ProxyCore')
@@ -147,6 +235,83 @@ abstract class ProxyCoreServiceBase extends $grpc.Service {
         false,
         ($core.List<$core.int> value) => $0.MeasurePingRequest.fromBuffer(value),
         ($0.MeasurePingResponse value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.StreamLogsRequest, $0.LogEntry>(
+        'streamLogs',
+        streamLogs_Pre,
+        false,
+        true,
+        ($core.List<$core.int> value) => $0.StreamLogsRequest.fromBuffer(value),
+        ($0.LogEntry value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.Empty, $0.CoreStateEvent>(
+        'watchCoreState',
+        watchCoreState_Pre,
+        false,
+        true,
+        ($core.List<$core.int> value) => $0.Empty.fromBuffer(value),
+        ($0.CoreStateEvent value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.TrafficStatsRequest, $0.TrafficStatsResponse>(
+        'getTrafficStats',
+        getTrafficStats_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) => $0.TrafficStatsRequest.fromBuffer(value),
+        ($0.TrafficStatsResponse value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.Empty, $0.ListConnectionsResponse>(
+        'listConnections',
+        listConnections_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) => $0.Empty.fromBuffer(value),
+        ($0.ListConnectionsResponse value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.CloseConnectionRequest, $0.Empty>(
+        'closeConnection',
+        closeConnection_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) => $0.CloseConnectionRequest.fromBuffer(value),
+        ($0.Empty value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.StartCoreRequest, $0.Empty>(
+        'reloadCore',
+        reloadCore_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) => $0.StartCoreRequest.fromBuffer(value),
+        ($0.Empty value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.ConvertShareLinkRequest, $0.ConvertShareLinkResponse>(
+        'convertShareLink',
+        convertShareLink_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) => $0.ConvertShareLinkRequest.fromBuffer(value),
+        ($0.ConvertShareLinkResponse value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.FetchSubscriptionRequest, $0.FetchSubscriptionResponse>(
+        'fetchSubscription',
+        fetchSubscription_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) => $0.FetchSubscriptionRequest.fromBuffer(value),
+        ($0.FetchSubscriptionResponse value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.TestConfigsRequest, $0.TestConfigResult>(
+        'testConfigs',
+        testConfigs_Pre,
+        false,
+        true,
+        ($core.List<$core.int> value) => $0.TestConfigsRequest.fromBuffer(value),
+        ($0.TestConfigResult value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.Empty, $0.OutboundStatusResponse>(
+        'getOutboundStatus',
+        getOutboundStatus_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) => $0.Empty.fromBuffer(value),
+        ($0.OutboundStatusResponse value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.Empty, $0.TunStatsResponse>(
+        'getTunStats',
+        getTunStats_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) => $0.Empty.fromBuffer(value),
+        ($0.TunStatsResponse value) => value.writeToBuffer()));
   }
 
   $async.Future<$0.Empty> startCore_Pre($grpc.ServiceCall $call, $async.Future<$0.StartCoreRequest> $request) async {
@@ -191,4 +356,70 @@ abstract class ProxyCoreServiceBase extends $grpc.Service {
 
   $async.Future<$0.MeasurePingResponse> measurePing($grpc.ServiceCall call, $0.MeasurePingRequest request);
 
+  $async.Stream<$0.LogEntry> streamLogs_Pre($grpc.ServiceCall $call, $async.Future<$0.StreamLogsRequest> $request) async* {
+    yield* streamLogs($call, await $request);
+  }
+
+  $async.Stream<$0.LogEntry> streamLogs($grpc.ServiceCall call, $0.StreamLogsRequest request);
+
+  $async.Stream<$0.CoreStateEvent> watchCoreState_Pre($grpc.ServiceCall $call, $async.Future<$0.Empty> $request) async* {
+    yield* watchCoreState($call, await $request);
+  }
+
+  $async.Stream<$0.CoreStateEvent> watchCoreState($grpc.ServiceCall call, $0.Empty request);
+
+  $async.Future<$0.TrafficStatsResponse> getTrafficStats_Pre($grpc.ServiceCall $call, $async.Future<$0.TrafficStatsRequest> $request) async {
+    return getTrafficStats($call, await $request);
+  }
+
+  $async.Future<$0.TrafficStatsResponse> getTrafficStats($grpc.ServiceCall call, $0.TrafficStatsRequest request);
+
+  $async.Future<$0.ListConnectionsResponse> listConnections_Pre($grpc.ServiceCall $call, $async.Future<$0.Empty> $request) async {
+    return listConnections($call, await $request);
+  }
+
+  $async.Future<$0.ListConnectionsResponse> listConnections($grpc.ServiceCall call, $0.Empty request);
+
+  $async.Future<$0.Empty> closeConnection_Pre($grpc.ServiceCall $call, $async.Future<$0.CloseConnectionRequest> $request) async {
+    return closeConnection($call, await $request);
+  }
+
+  $async.Future<$0.Empty> closeConnection($grpc.ServiceCall call, $0.CloseConnectionRequest request);
+
+  $async.Future<$0.Empty> reloadCore_Pre($grpc.ServiceCall $call, $async.Future<$0.StartCoreRequest> $request) async {
+    return reloadCore($call, await $request);
+  }
+
+  $async.Future<$0.Empty> reloadCore($grpc.ServiceCall call, $0.StartCoreRequest request);
+
+  $async.Future<$0.ConvertShareLinkResponse> convertShareLink_Pre($grpc.ServiceCall $call, $async.Future<$0.ConvertShareLinkRequest> $request) async {
+    return convertShareLink($call, await $request);
+  }
+
+  $async.Future<$0.ConvertShareLinkResponse> convertShareLink($grpc.ServiceCall call, $0.ConvertShareLinkRequest request);
+
+  $async.Future<$0.FetchSubscriptionResponse> fetchSubscription_Pre($grpc.ServiceCall $call, $async.Future<$0.FetchSubscriptionRequest> $request) async {
+    return fetchSubscription($call, await $request);
+  }
+
+  $async.Future<$0.FetchSubscriptionResponse> fetchSubscription($grpc.ServiceCall call, $0.FetchSubscriptionRequest request);
+
+  $async.Stream<$0.TestConfigResult> testConfigs_Pre($grpc.ServiceCall $call, $async.Future<$0.TestConfigsRequest> $request) async* {
+    yield* testConfigs($call, await $request);
+  }
+
+  $async.Stream<$0.TestConfigResult> testConfigs($grpc.ServiceCall call, $0.TestConfigsRequest request);
+
+  $async.Future<$0.OutboundStatusResponse> getOutboundStatus_Pre($grpc.ServiceCall $call, $async.Future<$0.Empty> $request) async {
+    return getOutboundStatus($call, await $request);
+  }
+
+  $async.Future<$0.OutboundStatusResponse> getOutboundStatus($grpc.ServiceCall call, $0.Empty request);
+
+  $async.Future<$0.TunStatsResponse> getTunStats_Pre($grpc.ServiceCall $call, $async.Future<$0.Empty> $request) async {
+    return getTunStats($call, await $request);
+  }
+
+  $async.Future<$0.TunStatsResponse> getTunStats($grpc.ServiceCall call, $0.Empty request);
+
 }
@@ -14,6 +14,45 @@ import 'dart:convert' as $convert;
 import 'dart:core' as $core;
 import 'dart:typed_data' as $typed_data;
 
+@$core.Deprecated('Use pingErrorCategoryDescriptor instead')
+const PingErrorCategory$json = {
+  '1': 'PingErrorCategory',
+  '2': [
+    {'1': 'PING_ERROR_NONE', '2': 0},
+    {'1': 'PING_ERROR_TIMEOUT', '2': 1},
+    {'1': 'PING_ERROR_REFUSED', '2': 2},
+    {'1': 'PING_ERROR_TLS', '2': 3},
+    {'1': 'PING_ERROR_BAD_STATUS', '2': 4},
+    {'1': 'PING_ERROR_DNS', '2': 5},
+    {'1': 'PING_ERROR_OTHER', '2': 6},
+  ],
+};
+
+/// Descriptor for `PingErrorCategory`. Decode as a `google.protobuf.EnumDescriptorProto`.
+final $typed_data.Uint8List pingErrorCategoryDescriptor = $convert.base64Decode(
+    'ChFQaW5nRXJyb3JDYXRlZ29yeRITCg9QSU5HX0VSUk9SX05PTkUQABIWChJQSU5HX0VSUk9SX1'
+    'RJTUVPVVQQARIWChJQSU5HX0VSUk9SX1JFRlVTRUQQAhISCg5QSU5HX0VSUk9SX1RMUxADEhkK'
+    'FVBJTkdfRVJST1JfQkFEX1NUQVRVUxAEEhIKDlBJTkdfRVJST1JfRE5TEAUSFAoQUElOR19FUl'
+    'JPUl9PVEhFUhAG');
+
+@$core.Deprecated('Use coreStateDescriptor instead')
+const CoreState$json = {
+  '1': 'CoreState',
+  '2': [
+    {'1': 'CORE_STATE_STOPPED', '2': 0},
+    {'1': 'CORE_STATE_STARTING', '2': 1},
+    {'1': 'CORE_STATE_RUNNING', '2': 2},
+    {'1': 'CORE_STATE_STOPPING', '2': 3},
+    {'1': 'CORE_STATE_FAILED', '2': 4},
+  ],
+};
+
+/// Descriptor for `CoreState`. Decode as a `google.protobuf.EnumDescriptorProto`.
+final $typed_data.Uint8List coreStateDescriptor = $convert.base64Decode(
+    'CglDb3JlU3RhdGUSFgoSQ09SRV9TVEFURV9TVE9QUEVEEAASFwoTQ09SRV9TVEFURV9TVEFSVE'
+    'lORxABEhYKEkNPUkVfU1RBVEVfUlVOTklORxACEhcKE0NPUkVfU1RBVEVfU1RPUFBJTkcQAxIV'
+    'ChFDT1JFX1NUQVRFX0ZBSUxFRBAE');
+
 @$core.Deprecated('Use startCoreRequestDescriptor instead')
 const StartCoreRequest$json = {
   '1': 'StartCoreRequest',
@@ -26,6 +65,11 @@ const StartCoreRequest$json = {
     {'1': 'isVpnMode', '3': 6, '4': 1, '5': 8, '10': 'isVpnMode'},
     {'1': 'tunFD', '3': 7, '4': 1, '5': 13, '10': 'tunFD'},
     {'1': 'proxyPort', '3': 8, '4': 1, '5': 5, '10': 'proxyPort'},
+    {'1': 'pool', '3': 9, '4': 1, '5': 11, '6': '.ProxyCore.PoolOptions', '10': 'pool'},
+    {'1': 'httpPort', '3': 10, '4': 1, '5': 5, '10': 'httpPort'},
+    {'1': 'mixed', '3': 11, '4': 1, '5': 8, '10': 'mixed'},
+    {'1': 'auth', '3': 12, '4': 1, '5': 11, '6': '.ProxyCore.ProxyAuth', '10': 'auth'},
+    {'1': 'tun', '3': 13, '4': 1, '5': 11, '6': '.ProxyCore.TunOptions', '10': 'tun'},
   ],
 };
 
@@ -34,7 +78,102 @@ final $typed_data.Uint8List startCoreRequestDescriptor = $convert.base64Decode(
     'ChBTdGFydENvcmVSZXF1ZXN0EhoKCGNvcmVOYW1lGAEgASgJUghjb3JlTmFtZRIQCgNkaXIYAi'
     'ABKAlSA2RpchIWCgZjb25maWcYAyABKAlSBmNvbmZpZxIWCgZtZW1vcnkYBCABKAVSBm1lbW9y'
     'eRIaCghpc1N0cmluZxgFIAEoCFIIaXNTdHJpbmcSHAoJaXNWcG5Nb2RlGAYgASgIUglpc1Zwbk'
-    '1vZGUSFAoFdHVuRkQYByABKA1SBXR1bkZEEhwKCXByb3h5UG9ydBgIIAEoBVIJcHJveHlQb3J0');
+    '1vZGUSFAoFdHVuRkQYByABKA1SBXR1bkZEEhwKCXByb3h5UG9ydBgIIAEoBVIJcHJveHlQb3J0'
+    'EioKBHBvb2wYCSABKAsyFi5Qcm94eUNvcmUuUG9vbE9wdGlvbnNSBHBvb2wSGgoIaHR0cFBvcn'
+    'QYCiABKAVSCGh0dHBQb3J0EhQKBW1peGVkGAsgASgIUgVtaXhlZBIoCgRhdXRoGAwgASgLMhQu'
+    'UHJveHlDb3JlLlByb3h5QXV0aFIEYXV0aBInCgN0dW4YDSABKAsyFS5Qcm94eUNvcmUuVHVuT3'
+    'B0aW9uc1IDdHVu');
+
+@$core.Deprecated('Use tunOptionsDescriptor instead')
+const TunOptions$json = {
+  '1': 'TunOptions',
+  '2': [
+    {'1': 'mtu', '3': 1, '4': 1, '5': 5, '10': 'mtu'},
+    {'1': 'udpTimeoutSec', '3': 2, '4': 1, '5': 5, '10': 'udpTimeoutSec'},
+    {'1': 'tcpSendBufferBytes', '3': 3, '4': 1, '5': 5, '10': 'tcpSendBufferBytes'},
+    {'1': 'tcpReceiveBufferBytes', '3': 4, '4': 1, '5': 5, '10': 'tcpReceiveBufferBytes'},
+    {'1': 'tcpModerateReceiveBuffer', '3': 5, '4': 1, '5': 8, '10': 'tcpModerateReceiveBuffer'},
+    {'1': 'logLevel', '3': 6, '4': 1, '5': 9, '10': 'logLevel'},
+    {'1': 'restApi', '3': 7, '4': 1, '5': 9, '10': 'restApi'},
+    {'1': 'multicastGroups', '3': 8, '4': 3, '5': 9, '10': 'multicastGroups'},
+    {'1': 'dns', '3': 9, '4': 1, '5': 11, '6': '.ProxyCore.TunDnsOptions', '10': 'dns'},
+    {'1': 'bypass', '3': 10, '4': 1, '5': 11, '6': '.ProxyCore.TunBypassOptions', '10': 'bypass'},
+  ],
+};
+
+/// Descriptor for `TunOptions`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List tunOptionsDescriptor = $convert.base64Decode(
+    'CgpUdW5PcHRpb25zEhAKA210dRgBIAEoBVIDbXR1EiQKDXVkcFRpbWVvdXRTZWMYAiABKAVSDX'
+    'VkcFRpbWVvdXRTZWMSLgoSdGNwU2VuZEJ1ZmZlckJ5dGVzGAMgASgFUhJ0Y3BTZW5kQnVmZmVy'
+    'Qnl0ZXMSNAoVdGNwUmVjZWl2ZUJ1ZmZlckJ5dGVzGAQgASgFUhV0Y3BSZWNlaXZlQnVmZmVyQn'
+    'l0ZXMSOgoYdGNwTW9kZXJhdGVSZWNlaXZlQnVmZmVyGAUgASgIUhh0Y3BNb2RlcmF0ZVJlY2Vp'
+    'dmVCdWZmZXISGgoIbG9nTGV2ZWwYBiABKAlSCGxvZ0xldmVsEhgKB3Jlc3RBcGkYByABKAlSB3'
+    'Jlc3RBcGkSKAoPbXVsdGljYXN0R3JvdXBzGAggAygJUg9tdWx0aWNhc3RHcm91cHMSKgoDZG5z'
+    'GAkgASgLMhguUHJveHlDb3JlLlR1bkRuc09wdGlvbnNSA2RucxIzCgZieXBhc3MYCiABKAsyGy'
+    '5Qcm94eUNvcmUuVHVuQnlwYXNzT3B0aW9uc1IGYnlwYXNz');
+
+@$core.Deprecated('Use tunDnsOptionsDescriptor instead')
+const TunDnsOptions$json = {
+  '1': 'TunDnsOptions',
+  '2': [
+    {'1': 'upstreams', '3': 1, '4': 3, '5': 9, '10': 'upstreams'},
+    {'1': 'fakeIp', '3': 2, '4': 1, '5': 8, '10': 'fakeIp'},
+    {'1': 'fakeIpRange', '3': 3, '4': 1, '5': 9, '10': 'fakeIpRange'},
+  ],
+};
+
+/// Descriptor for `TunDnsOptions`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List tunDnsOptionsDescriptor = $convert.base64Decode(
+    'Cg1UdW5EbnNPcHRpb25zEhwKCXVwc3RyZWFtcxgBIAMoCVIJdXBzdHJlYW1zEhYKBmZha2VJcB'
+    'gCIAEoCFIGZmFrZUlwEiAKC2Zha2VJcFJhbmdlGAMgASgJUgtmYWtlSXBSYW5nZQ==');
+
+@$core.Deprecated('Use tunBypassOptionsDescriptor instead')
+const TunBypassOptions$json = {
+  '1': 'TunBypassOptions',
+  '2': [
+    {'1': 'private', '3': 1, '4': 1, '5': 8, '10': 'private'},
+    {'1': 'cidrs', '3': 2, '4': 3, '5': 9, '10': 'cidrs'},
+    {'1': 'domains', '3': 3, '4': 3, '5': 9, '10': 'domains'},
+    {'1': 'geoip', '3': 4, '4': 3, '5': 9, '10': 'geoip'},
+  ],
+};
+
+/// Descriptor for `TunBypassOptions`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List tunBypassOptionsDescriptor = $convert.base64Decode(
+    'ChBUdW5CeXBhc3NPcHRpb25zEhgKB3ByaXZhdGUYASABKAhSB3ByaXZhdGUSFAoFY2lkcnMYAi'
+    'ADKAlSBWNpZHJzEhgKB2RvbWFpbnMYAyADKAlSB2RvbWFpbnMSFAoFZ2VvaXAYBCADKAlSBWdl'
+    'b2lw');
+
+@$core.Deprecated('Use proxyAuthDescriptor instead')
+const ProxyAuth$json = {
+  '1': 'ProxyAuth',
+  '2': [
+    {'1': 'username', '3': 1, '4': 1, '5': 9, '10': 'username'},
+    {'1': 'password', '3': 2, '4': 1, '5': 9, '10': 'password'},
+  ],
+};
+
+/// Descriptor for `ProxyAuth`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List proxyAuthDescriptor = $convert.base64Decode(
+    'CglQcm94eUF1dGgSGgoIdXNlcm5hbWUYASABKAlSCHVzZXJuYW1lEhoKCHBhc3N3b3JkGAIgAS'
+    'gJUghwYXNzd29yZA==');
+
+@$core.Deprecated('Use poolOptionsDescriptor instead')
+const PoolOptions$json = {
+  '1': 'PoolOptions',
+  '2': [
+    {'1': 'servers', '3': 1, '4': 3, '5': 9, '10': 'servers'},
+    {'1': 'strategy', '3': 2, '4': 1, '5': 9, '10': 'strategy'},
+    {'1': 'probeUrl', '3': 3, '4': 1, '5': 9, '10': 'probeUrl'},
+    {'1': 'probeIntervalSec', '3': 4, '4': 1, '5': 5, '10': 'probeIntervalSec'},
+  ],
+};
+
+/// Descriptor for `PoolOptions`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List poolOptionsDescriptor = $convert.base64Decode(
+    'CgtQb29sT3B0aW9ucxIYCgdzZXJ2ZXJzGAEgAygJUgdzZXJ2ZXJzEhoKCHN0cmF0ZWd5GAIgAS'
+    'gJUghzdHJhdGVneRIaCghwcm9iZVVybBgDIAEoCVIIcHJvYmVVcmwSKgoQcHJvYmVJbnRlcnZh'
+    'bFNlYxgEIAEoBVIQcHJvYmVJbnRlcnZhbFNlYw==');
 
 @$core.Deprecated('Use measurePingRequestDescriptor instead')
 const MeasurePingRequest$json = {
@@ -48,6 +187,107 @@ const MeasurePingRequest$json = {
 final $typed_data.Uint8List measurePingRequestDescriptor = $convert.base64Decode(
     'ChJNZWFzdXJlUGluZ1JlcXVlc3QSEAoDdXJsGAEgAygJUgN1cmw=');
 
+@$core.Deprecated('Use streamLogsRequestDescriptor instead')
+const StreamLogsRequest$json = {
+  '1': 'StreamLogsRequest',
+  '2': [
+    {'1': 'includeBacklog', '3': 1, '4': 1, '5': 8, '10': 'includeBacklog'},
+  ],
+};
+
+/// Descriptor for `StreamLogsRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List streamLogsRequestDescriptor = $convert.base64Decode(
+    'ChFTdHJlYW1Mb2dzUmVxdWVzdBImCg5pbmNsdWRlQmFja2xvZxgBIAEoCFIOaW5jbHVkZUJhY2'
+    'tsb2c=');
+
+@$core.Deprecated('Use trafficStatsRequestDescriptor instead')
+const TrafficStatsRequest$json = {
+  '1': 'TrafficStatsRequest',
+  '2': [
+    {'1': 'resetCounters', '3': 1, '4': 1, '5': 8, '10': 'resetCounters'},
+  ],
+};
+
+/// Descriptor for `TrafficStatsRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List trafficStatsRequestDescriptor = $convert.base64Decode(
+    'ChNUcmFmZmljU3RhdHNSZXF1ZXN0EiQKDXJlc2V0Q291bnRlcnMYASABKAhSDXJlc2V0Q291bn'
+    'RlcnM=');
+
+@$core.Deprecated('Use closeConnectionRequestDescriptor instead')
+const CloseConnectionRequest$json = {
+  '1': 'CloseConnectionRequest',
+  '2': [
+    {'1': 'id', '3': 1, '4': 1, '5': 4, '10': 'id'},
+  ],
+};
+
+/// Descriptor for `CloseConnectionRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List closeConnectionRequestDescriptor = $convert.base64Decode(
+    'ChZDbG9zZUNvbm5lY3Rpb25SZXF1ZXN0Eg4KAmlkGAEgASgEUgJpZA==');
+
+@$core.Deprecated('Use convertShareLinkRequestDescriptor instead')
+const ConvertShareLinkRequest$json = {
+  '1': 'ConvertShareLinkRequest',
+  '2': [
+    {'1': 'link', '3': 1, '4': 1, '5': 9, '10': 'link'},
+    {'1': 'proxyPort', '3': 2, '4': 1, '5': 5, '10': 'proxyPort'},
+  ],
+};
+
+/// Descriptor for `ConvertShareLinkRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List convertShareLinkRequestDescriptor = $convert.base64Decode(
+    'ChdDb252ZXJ0U2hhcmVMaW5rUmVxdWVzdBISCgRsaW5rGAEgASgJUgRsaW5rEhwKCXByb3h5UG'
+    '9ydBgCIAEoBVIJcHJveHlQb3J0');
+
+@$core.Deprecated('Use fetchSubscriptionRequestDescriptor instead')
+const FetchSubscriptionRequest$json = {
+  '1': 'FetchSubscriptionRequest',
+  '2': [
+    {'1': 'url', '3': 1, '4': 1, '5': 9, '10': 'url'},
+    {'1': 'viaCore', '3': 2, '4': 1, '5': 8, '10': 'viaCore'},
+    {'1': 'userAgent', '3': 3, '4': 1, '5': 9, '10': 'userAgent'},
+    {'1': 'timeoutMs', '3': 4, '4': 1, '5': 5, '10': 'timeoutMs'},
+  ],
+};
+
+/// Descriptor for `FetchSubscriptionRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List fetchSubscriptionRequestDescriptor = $convert.base64Decode(
+    'ChhGZXRjaFN1YnNjcmlwdGlvblJlcXVlc3QSEAoDdXJsGAEgASgJUgN1cmwSGAoHdmlhQ29yZR'
+    'gCIAEoCFIHdmlhQ29yZRIcCgl1c2VyQWdlbnQYAyABKAlSCXVzZXJBZ2VudBIcCgl0aW1lb3V0'
+    'TXMYBCABKAVSCXRpbWVvdXRNcw==');
+
+@$core.Deprecated('Use testConfigsRequestDescriptor instead')
+const TestConfigsRequest$json = {
+  '1': 'TestConfigsRequest',
+  '2': [
+    {'1': 'configs', '3': 1, '4': 3, '5': 11, '6': '.ProxyCore.TestConfig', '10': 'configs'},
+    {'1': 'url', '3': 2, '4': 1, '5': 9, '10': 'url'},
+    {'1': 'concurrency', '3': 3, '4': 1, '5': 5, '10': 'concurrency'},
+    {'1': 'timeoutMs', '3': 4, '4': 1, '5': 5, '10': 'timeoutMs'},
+  ],
+};
+
+/// Descriptor for `TestConfigsRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List testConfigsRequestDescriptor = $convert.base64Decode(
+    'ChJUZXN0Q29uZmlnc1JlcXVlc3QSLwoHY29uZmlncxgBIAMoCzIVLlByb3h5Q29yZS5UZXN0Q2'
+    '9uZmlnUgdjb25maWdzEhAKA3VybBgCIAEoCVIDdXJsEiAKC2NvbmN1cnJlbmN5GAMgASgFUgtj'
+    'b25jdXJyZW5jeRIcCgl0aW1lb3V0TXMYBCABKAVSCXRpbWVvdXRNcw==');
+
+@$core.Deprecated('Use testConfigDescriptor instead')
+const TestConfig$json = {
+  '1': 'TestConfig',
+  '2': [
+    {'1': 'id', '3': 1, '4': 1, '5': 9, '10': 'id'},
+    {'1': 'coreName', '3': 2, '4': 1, '5': 9, '10': 'coreName'},
+    {'1': 'config', '3': 3, '4': 1, '5': 9, '10': 'config'},
+  ],
+};
+
+/// Descriptor for `TestConfig`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List testConfigDescriptor = $convert.base64Decode(
+    'CgpUZXN0Q29uZmlnEg4KAmlkGAEgASgJUgJpZBIaCghjb3JlTmFtZRgCIAEoCVIIY29yZU5hbW'
+    'USFgoGY29uZmlnGAMgASgJUgZjb25maWc=');
+
 @$core.Deprecated('Use booleanResponseDescriptor instead')
 const BooleanResponse$json = {
   '1': 'BooleanResponse',
@@ -103,12 +343,269 @@ const PingResult$json = {
   '2': [
     {'1': 'url', '3': 1, '4': 1, '5': 9, '10': 'url'},
     {'1': 'delay', '3': 2, '4': 1, '5': 3, '10': 'delay'},
+    {'1': 'dnsDelay', '3': 3, '4': 1, '5': 3, '10': 'dnsDelay'},
+    {'1': 'connectDelay', '3': 4, '4': 1, '5': 3, '10': 'connectDelay'},
+    {'1': 'tlsDelay', '3': 5, '4': 1, '5': 3, '10': 'tlsDelay'},
+    {'1': 'firstByteDelay', '3': 6, '4': 1, '5': 3, '10': 'firstByteDelay'},
+    {'1': 'statusCode', '3': 7, '4': 1, '5': 5, '10': 'statusCode'},
+    {'1': 'errorCategory', '3': 8, '4': 1, '5': 14, '6': '.ProxyCore.PingErrorCategory', '10': 'errorCategory'},
+    {'1': 'error', '3': 9, '4': 1, '5': 9, '10': 'error'},
   ],
 };
 
 /// Descriptor for `PingResult`. Decode as a `google.protobuf.DescriptorProto`.
 final $typed_data.Uint8List pingResultDescriptor = $convert.base64Decode(
-    'CgpQaW5nUmVzdWx0EhAKA3VybBgBIAEoCVIDdXJsEhQKBWRlbGF5GAIgASgDUgVkZWxheQ==');
+    'CgpQaW5nUmVzdWx0EhAKA3VybBgBIAEoCVIDdXJsEhQKBWRlbGF5GAIgASgDUgVkZWxheRIaCg'
+    'hkbnNEZWxheRgDIAEoA1IIZG5zRGVsYXkSIgoMY29ubmVjdERlbGF5GAQgASgDUgxjb25uZWN0'
+    'RGVsYXkSGgoIdGxzRGVsYXkYBSABKANSCHRsc0RlbGF5EiYKDmZpcnN0Qnl0ZURlbGF5GAYgAS'
+    'gDUg5maXJzdEJ5dGVEZWxheRIeCgpzdGF0dXNDb2RlGAcgASgFUgpzdGF0dXNDb2RlEkIKDWVy'
+    'cm9yQ2F0ZWdvcnkYCCABKA4yHC5Qcm94eUNvcmUuUGluZ0Vycm9yQ2F0ZWdvcnlSDWVycm9yQ2'
+    'F0ZWdvcnkSFAoFZXJyb3IYCSABKAlSBWVycm9y');
+
+@$core.Deprecated('Use trafficStatsResponseDescriptor instead')
+const TrafficStatsResponse$json = {
+  '1': 'TrafficStatsResponse',
+  '2': [
+    {'1': 'uplink', '3': 1, '4': 1, '5': 3, '10': 'uplink'},
+    {'1': 'downlink', '3': 2, '4': 1, '5': 3, '10': 'downlink'},
+    {'1': 'uplinkRate', '3': 3, '4': 1, '5': 3, '10': 'uplinkRate'},
+    {'1': 'downlinkRate', '3': 4, '4': 1, '5': 3, '10': 'downlinkRate'},
+    {'1': 'connections', '3': 5, '4': 1, '5': 5, '10': 'connections'},
+  ],
+};
+
+/// Descriptor for `TrafficStatsResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List trafficStatsResponseDescriptor = $convert.base64Decode(
+    'ChRUcmFmZmljU3RhdHNSZXNwb25zZRIWCgZ1cGxpbmsYASABKANSBnVwbGluaxIaCghkb3dubG'
+    'luaxgCIAEoA1IIZG93bmxpbmsSHgoKdXBsaW5rUmF0ZRgDIAEoA1IKdXBsaW5rUmF0ZRIiCgxk'
+    'b3dubGlua1JhdGUYBCABKANSDGRvd25saW5rUmF0ZRIgCgtjb25uZWN0aW9ucxgFIAEoBVILY2'
+    '9ubmVjdGlvbnM=');
+
+@$core.Deprecated('Use tunStatsResponseDescriptor instead')
+const TunStatsResponse$json = {
+  '1': 'TunStatsResponse',
+  '2': [
+    {'1': 'packetsIn', '3': 1, '4': 1, '5': 4, '10': 'packetsIn'},
+    {'1': 'packetsOut', '3': 2, '4': 1, '5': 4, '10': 'packetsOut'},
+    {'1': 'bytesIn', '3': 3, '4': 1, '5': 4, '10': 'bytesIn'},
+    {'1': 'bytesOut', '3': 4, '4': 1, '5': 4, '10': 'bytesOut'},
+    {'1': 'droppedPackets', '3': 5, '4': 1, '5': 4, '10': 'droppedPackets'},
+    {'1': 'tcpSessions', '3': 6, '4': 1, '5': 5, '10': 'tcpSessions'},
+    {'1': 'udpSessions', '3': 7, '4': 1, '5': 5, '10': 'udpSessions'},
+    {'1': 'uptimeSec', '3': 8, '4': 1, '5': 3, '10': 'uptimeSec'},
+  ],
+};
+
+/// Descriptor for `TunStatsResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List tunStatsResponseDescriptor = $convert.base64Decode(
+    'ChBUdW5TdGF0c1Jlc3BvbnNlEhwKCXBhY2tldHNJbhgBIAEoBFIJcGFja2V0c0luEh4KCnBhY2'
+    'tldHNPdXQYAiABKARSCnBhY2tldHNPdXQSGAoHYnl0ZXNJbhgDIAEoBFIHYnl0ZXNJbhIaCghi'
+    'eXRlc091dBgEIAEoBFIIYnl0ZXNPdXQSJgoOZHJvcHBlZFBhY2tldHMYBSABKARSDmRyb3BwZW'
+    'RQYWNrZXRzEiAKC3RjcFNlc3Npb25zGAYgASgFUgt0Y3BTZXNzaW9ucxIgCgt1ZHBTZXNzaW9u'
+    'cxgHIAEoBVILdWRwU2Vzc2lvbnMSHAoJdXB0aW1lU2VjGAggASgDUgl1cHRpbWVTZWM=');
+
+@$core.Deprecated('Use listConnectionsResponseDescriptor instead')
+const ListConnectionsResponse$json = {
+  '1': 'ListConnectionsResponse',
+  '2': [
+    {'1': 'connections', '3': 1, '4': 3, '5': 11, '6': '.ProxyCore.Connection', '10': 'connections'},
+  ],
+};
+
+/// Descriptor for `ListConnectionsResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List listConnectionsResponseDescriptor = $convert.base64Decode(
+    'ChdMaXN0Q29ubmVjdGlvbnNSZXNwb25zZRI3Cgtjb25uZWN0aW9ucxgBIAMoCzIVLlByb3h5Q2'
+    '9yZS5Db25uZWN0aW9uUgtjb25uZWN0aW9ucw==');
+
+@$core.Deprecated('Use connectionDescriptor instead')
+const Connection$json = {
+  '1': 'Connection',
+  '2': [
+    {'1': 'id', '3': 1, '4': 1, '5': 4, '10': 'id'},
+    {'1': 'coreName', '3': 2, '4': 1, '5': 9, '10': 'coreName'},
+    {'1': 'network', '3': 3, '4': 1, '5': 9, '10': 'network'},
+    {'1': 'source', '3': 4, '4': 1, '5': 9, '10': 'source'},
+    {'1': 'destination', '3': 5, '4': 1, '5': 9, '10': 'destination'},
+    {'1': 'inboundTag', '3': 6, '4': 1, '5': 9, '10': 'inboundTag'},
+    {'1': 'outboundTag', '3': 7, '4': 1, '5': 9, '10': 'outboundTag'},
+    {'1': 'startTime', '3': 8, '4': 1, '5': 3, '10': 'startTime'},
+    {'1': 'uplink', '3': 9, '4': 1, '5': 3, '10': 'uplink'},
+    {'1': 'downlink', '3': 10, '4': 1, '5': 3, '10': 'downlink'},
+  ],
+};
+
+/// Descriptor for `Connection`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List connectionDescriptor = $convert.base64Decode(
+    'CgpDb25uZWN0aW9uEg4KAmlkGAEgASgEUgJpZBIaCghjb3JlTmFtZRgCIAEoCVIIY29yZU5hbW'
+    'USGAoHbmV0d29yaxgDIAEoCVIHbmV0d29yaxIWCgZzb3VyY2UYBCABKAlSBnNvdXJjZRIgCgtk'
+    'ZXN0aW5hdGlvbhgFIAEoCVILZGVzdGluYXRpb24SHgoKaW5ib3VuZFRhZxgGIAEoCVIKaW5ib3'
+    'VuZFRhZxIgCgtvdXRib3VuZFRhZxgHIAEoCVILb3V0Ym91bmRUYWcSHAoJc3RhcnRUaW1lGAgg'
+    'ASgDUglzdGFydFRpbWUSFgoGdXBsaW5rGAkgASgDUgZ1cGxpbmsSGgoIZG93bmxpbmsYCiABKA'
+    'NSCGRvd25saW5r');
+
+@$core.Deprecated('Use convertShareLinkResponseDescriptor instead')
+const ConvertShareLinkResponse$json = {
+  '1': 'ConvertShareLinkResponse',
+  '2': [
+    {'1': 'config', '3': 1, '4': 1, '5': 9, '10': 'config'},
+    {'1': 'name', '3': 2, '4': 1, '5': 9, '10': 'name'},
+  ],
+};
+
+/// Descriptor for `ConvertShareLinkResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List convertShareLinkResponseDescriptor = $convert.base64Decode(
+    'ChhDb252ZXJ0U2hhcmVMaW5rUmVzcG9uc2USFgoGY29uZmlnGAEgASgJUgZjb25maWcSEgoEbm'
+    'FtZRgCIAEoCVIEbmFtZQ==');
+
+@$core.Deprecated('Use fetchSubscriptionResponseDescriptor instead')
+const FetchSubscriptionResponse$json = {
+  '1': 'FetchSubscriptionResponse',
+  '2': [
+    {'1': 'title', '3': 1, '4': 1, '5': 9, '10': 'title'},
+    {'1': 'entries', '3': 2, '4': 3, '5': 11, '6': '.ProxyCore.SubscriptionEntry', '10': 'entries'},
+    {'1': 'userinfo', '3': 3, '4': 1, '5': 11, '6': '.ProxyCore.SubscriptionUserinfo', '10': 'userinfo'},
+    {'1': 'skipped', '3': 4, '4': 1, '5': 5, '10': 'skipped'},
+  ],
+};
+
+/// Descriptor for `FetchSubscriptionResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List fetchSubscriptionResponseDescriptor = $convert.base64Decode(
+    'ChlGZXRjaFN1YnNjcmlwdGlvblJlc3BvbnNlEhQKBXRpdGxlGAEgASgJUgV0aXRsZRI2Cgdlbn'
+    'RyaWVzGAIgAygLMhwuUHJveHlDb3JlLlN1YnNjcmlwdGlvbkVudHJ5UgdlbnRyaWVzEjsKCHVz'
+    'ZXJpbmZvGAMgASgLMh8uUHJveHlDb3JlLlN1YnNjcmlwdGlvblVzZXJpbmZvUgh1c2VyaW5mbx'
+    'IYCgdza2lwcGVkGAQgASgFUgdza2lwcGVk');
+
+@$core.Deprecated('Use subscriptionEntryDescriptor instead')
+const SubscriptionEntry$json = {
+  '1': 'SubscriptionEntry',
+  '2': [
+    {'1': 'name', '3': 1, '4': 1, '5': 9, '10': 'name'},
+    {'1': 'remark', '3': 2, '4': 1, '5': 9, '10': 'remark'},
+    {'1': 'protocol', '3': 3, '4': 1, '5': 9, '10': 'protocol'},
+    {'1': 'address', '3': 4, '4': 1, '5': 9, '10': 'address'},
+    {'1': 'port', '3': 5, '4': 1, '5': 5, '10': 'port'},
+    {'1': 'link', '3': 6, '4': 1, '5': 9, '10': 'link'},
+  ],
+};
+
+/// Descriptor for `SubscriptionEntry`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List subscriptionEntryDescriptor = $convert.base64Decode(
+    'ChFTdWJzY3JpcHRpb25FbnRyeRISCgRuYW1lGAEgASgJUgRuYW1lEhYKBnJlbWFyaxgCIAEoCV'
+    'IGcmVtYXJrEhoKCHByb3RvY29sGAMgASgJUghwcm90b2NvbBIYCgdhZGRyZXNzGAQgASgJUgdh'
+    'ZGRyZXNzEhIKBHBvcnQYBSABKAVSBHBvcnQSEgoEbGluaxgGIAEoCVIEbGluaw==');
+
+@$core.Deprecated('Use subscriptionUserinfoDescriptor instead')
+const SubscriptionUserinfo$json = {
+  '1': 'SubscriptionUserinfo',
+  '2': [
+    {'1': 'upload', '3': 1, '4': 1, '5': 3, '10': 'upload'},
+    {'1': 'download', '3': 2, '4': 1, '5': 3, '10': 'download'},
+    {'1': 'total', '3': 3, '4': 1, '5': 3, '10': 'total'},
+    {'1': 'expire', '3': 4, '4': 1, '5': 3, '10': 'expire'},
+  ],
+};
+
+/// Descriptor for `SubscriptionUserinfo`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List subscriptionUserinfoDescriptor = $convert.base64Decode(
+    'ChRTdWJzY3JpcHRpb25Vc2VyaW5mbxIWCgZ1cGxvYWQYASABKANSBnVwbG9hZBIaCghkb3dubG'
+    '9hZBgCIAEoA1IIZG93bmxvYWQSFAoFdG90YWwYAyABKANSBXRvdGFsEhYKBmV4cGlyZRgEIAEo'
+    'A1IGZXhwaXJl');
+
+@$core.Deprecated('Use testConfigResultDescriptor instead')
+const TestConfigResult$json = {
+  '1': 'TestConfigResult',
+  '2': [
+    {'1': 'id', '3': 1, '4': 1, '5': 9, '10': 'id'},
+    {'1': 'index', '3': 2, '4': 1, '5': 5, '10': 'index'},
+    {'1': 'coreName', '3': 3, '4': 1, '5': 9, '10': 'coreName'},
+    {'1': 'connectDelay', '3': 4, '4': 1, '5': 3, '10': 'connectDelay'},
+    {'1': 'tlsDelay', '3': 5, '4': 1, '5': 3, '10': 'tlsDelay'},
+    {'1': 'httpDelay', '3': 6, '4': 1, '5': 3, '10': 'httpDelay'},
+    {'1': 'delay', '3': 7, '4': 1, '5': 3, '10': 'delay'},
+    {'1': 'statusCode', '3': 8, '4': 1, '5': 5, '10': 'statusCode'},
+    {'1': 'error', '3': 9, '4': 1, '5': 9, '10': 'error'},
+    {'1': 'errorCategory', '3': 10, '4': 1, '5': 14, '6': '.ProxyCore.PingErrorCategory', '10': 'errorCategory'},
+  ],
+};
+
+/// Descriptor for `TestConfigResult`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List testConfigResultDescriptor = $convert.base64Decode(
+    'ChBUZXN0Q29uZmlnUmVzdWx0Eg4KAmlkGAEgASgJUgJpZBIUCgVpbmRleBgCIAEoBVIFaW5kZX'
+    'gSGgoIY29yZU5hbWUYAyABKAlSCGNvcmVOYW1lEiIKDGNvbm5lY3REZWxheRgEIAEoA1IMY29u'
+    'bmVjdERlbGF5EhoKCHRsc0RlbGF5GAUgASgDUgh0bHNEZWxheRIcCglodHRwRGVsYXkYBiABKA'
+    'NSCWh0dHBEZWxheRIUCgVkZWxheRgHIAEoA1IFZGVsYXkSHgoKc3RhdHVzQ29kZRgIIAEoBVIK'
+    'c3RhdHVzQ29kZRIUCgVlcnJvchgJIAEoCVIFZXJyb3ISQgoNZXJyb3JDYXRlZ29yeRgKIAEoDj'
+    'IcLlByb3h5Q29yZS5QaW5nRXJyb3JDYXRlZ29yeVINZXJyb3JDYXRlZ29yeQ==');
+
+@$core.Deprecated('Use outboundStatusResponseDescriptor instead')
+const OutboundStatusResponse$json = {
+  '1': 'OutboundStatusResponse',
+  '2': [
+    {'1': 'selected', '3': 1, '4': 1, '5': 9, '10': 'selected'},
+    {'1': 'outbounds', '3': 2, '4': 3, '5': 11, '6': '.ProxyCore.OutboundHealth', '10': 'outbounds'},
+  ],
+};
+
+/// Descriptor for `OutboundStatusResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List outboundStatusResponseDescriptor = $convert.base64Decode(
+    'ChZPdXRib3VuZFN0YXR1c1Jlc3BvbnNlEhoKCHNlbGVjdGVkGAEgASgJUghzZWxlY3RlZBI3Cg'
+    'lvdXRib3VuZHMYAiADKAsyGS5Qcm94eUNvcmUuT3V0Ym91bmRIZWFsdGhSCW91dGJvdW5kcw==');
+
+@$core.Deprecated('Use outboundHealthDescriptor instead')
+const OutboundHealth$json = {
+  '1': 'OutboundHealth',
+  '2': [
+    {'1': 'tag', '3': 1, '4': 1, '5': 9, '10': 'tag'},
+    {'1': 'name', '3': 2, '4': 1, '5': 9, '10': 'name'},
+    {'1': 'alive', '3': 3, '4': 1, '5': 8, '10': 'alive'},
+    {'1': 'delay', '3': 4, '4': 1, '5': 3, '10': 'delay'},
+    {'1': 'error', '3': 5, '4': 1, '5': 9, '10': 'error'},
+    {'1': 'lastSeenTime', '3': 6, '4': 1, '5': 3, '10': 'lastSeenTime'},
+    {'1': 'lastTryTime', '3': 7, '4': 1, '5': 3, '10': 'lastTryTime'},
+  ],
+};
+
+/// Descriptor for `OutboundHealth`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List outboundHealthDescriptor = $convert.base64Decode(
+    'Cg5PdXRib3VuZEhlYWx0aBIQCgN0YWcYASABKAlSA3RhZxISCgRuYW1lGAIgASgJUgRuYW1lEh'
+    'QKBWFsaXZlGAMgASgIUgVhbGl2ZRIUCgVkZWxheRgEIAEoA1IFZGVsYXkSFAoFZXJyb3IYBSAB'
+    'KAlSBWVycm9yEiIKDGxhc3RTZWVuVGltZRgGIAEoA1IMbGFzdFNlZW5UaW1lEiAKC2xhc3RUcn'
+    'lUaW1lGAcgASgDUgtsYXN0VHJ5VGltZQ==');
+
+@$core.Deprecated('Use logEntryDescriptor instead')
+const LogEntry$json = {
+  '1': 'LogEntry',
+  '2': [
+    {'1': 'seq', '3': 1, '4': 1, '5': 4, '10': 'seq'},
+    {'1': 'timestamp', '3': 2, '4': 1, '5': 3, '10': 'timestamp'},
+    {'1': 'level', '3': 3, '4': 1, '5': 9, '10': 'level'},
+    {'1': 'coreName', '3': 4, '4': 1, '5': 9, '10': 'coreName'},
+    {'1': 'message', '3': 5, '4': 1, '5': 9, '10': 'message'},
+  ],
+};
+
+/// Descriptor for `LogEntry`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List logEntryDescriptor = $convert.base64Decode(
+    'CghMb2dFbnRyeRIQCgNzZXEYASABKARSA3NlcRIcCgl0aW1lc3RhbXAYAiABKANSCXRpbWVzdG'
+    'FtcBIUCgVsZXZlbBgDIAEoCVIFbGV2ZWwSGgoIY29yZU5hbWUYBCABKAlSCGNvcmVOYW1lEhgK'
+    'B21lc3NhZ2UYBSABKAlSB21lc3NhZ2U=');
+
+@$core.Deprecated('Use coreStateEventDescriptor instead')
+const CoreStateEvent$json = {
+  '1': 'CoreStateEvent',
+  '2': [
+    {'1': 'component', '3': 1, '4': 1, '5': 9, '10': 'component'},
+    {'1': 'state', '3': 2, '4': 1, '5': 14, '6': '.ProxyCore.CoreState', '10': 'state'},
+    {'1': 'error', '3': 3, '4': 1, '5': 9, '10': 'error'},
+    {'1': 'timestamp', '3': 4, '4': 1, '5': 3, '10': 'timestamp'},
+  ],
+};
+
+/// Descriptor for `CoreStateEvent`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List coreStateEventDescriptor = $convert.base64Decode(
+    'Cg5Db3JlU3RhdGVFdmVudBIcCgljb21wb25lbnQYASABKAlSCWNvbXBvbmVudBIqCgVzdGF0ZR'
+    'gCIAEoDjIULlByb3h5Q29yZS5Db3JlU3RhdGVSBXN0YXRlEhQKBWVycm9yGAMgASgJUgVlcnJv'
+    'chIcCgl0aW1lc3RhbXAYBCABKANSCXRpbWVzdGFtcA==');
 
 @$core.Deprecated('Use emptyDescriptor instead')
 const Empty$json = {
@@ -28,13 +28,87 @@ class ProxyCoreBindings {
           lookup)
       : _lookup = lookup;
 
-  int GRPCSERVER() {
+  ffi.Pointer<ffi.Char> GRPCSERVER() {
     return _GRPCSERVER();
   }
 
   late final _GRPCSERVERPtr =
-      _lookup<ffi.NativeFunction<GoUint8 Function()>>('GRPCSERVER');
-  late final _GRPCSERVER = _GRPCSERVERPtr.asFunction<int Function()>();
+      _lookup<ffi.NativeFunction<ffi.Pointer<ffi.Char> Function()>>(
+          'GRPCSERVER');
+  late final _GRPCSERVER =
+      _GRPCSERVERPtr.asFunction<ffi.Pointer<ffi.Char> Function()>();
+
+  ffi.Pointer<ffi.Char> GRPCSERVER_LISTEN(
+    ffi.Pointer<ffi.Char> listen,
+  ) {
+    return _GRPCSERVER_LISTEN(
+      listen,
+    );
+  }
+
+  late final _GRPCSERVER_LISTENPtr = _lookup<
+          ffi.NativeFunction<
+              ffi.Pointer<ffi.Char> Function(
+                  ffi.Pointer<ffi.Char>)>>('GRPCSERVER_LISTEN');
+  late final _GRPCSERVER_LISTEN = _GRPCSERVER_LISTENPtr
+      .asFunction<ffi.Pointer<ffi.Char> Function(ffi.Pointer<ffi.Char>)>();
+
+  ffi.Pointer<ffi.Char> GRPCSERVER_TOKEN() {
+    return _GRPCSERVER_TOKEN();
+  }
+
+  late final _GRPCSERVER_TOKENPtr =
+      _lookup<ffi.NativeFunction<ffi.Pointer<ffi.Char> Function()>>(
+          'GRPCSERVER_TOKEN');
+  late final _GRPCSERVER_TOKEN =
+      _GRPCSERVER_TOKENPtr.asFunction<ffi.Pointer<ffi.Char> Function()>();
+
+  int GRPCSERVER_STOP() {
+    return _GRPCSERVER_STOP();
+  }
+
+  late final _GRPCSERVER_STOPPtr =
+      _lookup<ffi.NativeFunction<GoUint8 Function()>>('GRPCSERVER_STOP');
+  late final _GRPCSERVER_STOP =
+      _GRPCSERVER_STOPPtr.asFunction<int Function()>();
+
+  ffi.Pointer<ffi.Char> GRPCSERVER_RESTART() {
+    return _GRPCSERVER_RESTART();
+  }
+
+  late final _GRPCSERVER_RESTARTPtr =
+      _lookup<ffi.NativeFunction<ffi.Pointer<ffi.Char> Function()>>(
+          'GRPCSERVER_RESTART');
+  late final _GRPCSERVER_RESTART =
+      _GRPCSERVER_RESTARTPtr.asFunction<ffi.Pointer<ffi.Char> Function()>();
+
+  void FREE_STRING(
+    ffi.Pointer<ffi.Char> s,
+  ) {
+    return _FREE_STRING(
+      s,
+    );
+  }
+
+  late final _FREE_STRINGPtr =
+      _lookup<ffi.NativeFunction<ffi.Void Function(ffi.Pointer<ffi.Char>)>>(
+          'FREE_STRING');
+  late final _FREE_STRING =
+      _FREE_STRINGPtr.asFunction<void Function(ffi.Pointer<ffi.Char>)>();
+
+  void SET_PROTECT_CALLBACK(
+    protect_fn fn,
+  ) {
+    return _SET_PROTECT_CALLBACK(
+      fn,
+    );
+  }
+
+  late final _SET_PROTECT_CALLBACKPtr =
+      _lookup<ffi.NativeFunction<ffi.Void Function(protect_fn)>>(
+          'SET_PROTECT_CALLBACK');
+  late final _SET_PROTECT_CALLBACK =
+      _SET_PROTECT_CALLBACKPtr.asFunction<void Function(protect_fn)>();
 
   void ENFORCE_BINDING() {
     return _ENFORCE_BINDING();
@@ -57,6 +131,10 @@ final class _GoString_ extends ffi.Struct {
   external int n;
 }
 
+typedef protect_fnFunction = ffi.Int Function(ffi.Int fd);
+typedef Dartprotect_fnFunction = int Function(int fd);
+typedef protect_fn = ffi.Pointer<ffi.NativeFunction<protect_fnFunction>>;
+
 typedef GoInt8 = ffi.SignedChar;
 typedef DartGoInt8 = int;
 typedef GoUint8 = ffi.UnsignedChar;
@@ -1,6 +1,7 @@
 import 'dart:async';
 import 'dart:io';
 
+import 'package:ffi/ffi.dart';
 import 'package:proxy_core/constants/grpc_channel_config.dart';
 import 'package:proxy_core/ffi/ffi.dart';
 import 'package:proxy_core/gen/bindings/ProxyCoreService.pbgrpc.dart';
@@ -42,12 +43,17 @@ class ProxyCoreBaseImpl
   void _initializeGrpcServer() {
     try {
       final result = nativeLib.GRPCSERVER();
-      if (result < 1) {
+      final token = result.cast<Utf8>().toDartString();
+      nativeLib.FREE_STRING(result);
+      if (token.startsWith('ERROR_SERVER')) {
         throw ProxyCoreException.message(
-          'GRPC server is not available/GRPC start failed.',
+          'GRPC server is not available/GRPC start failed: $token',
         );
       }
-      _grpcClient = ProxyCoreClient(grpcChannelConfig);
+      _grpcClient = ProxyCoreClient(
+        grpcChannelConfig,
+        options: grpcCallOptions(token),
+      );
       _isGrpcServerInitialized = true;
     } catch (e, stackTrace) {
       throw ProxyCoreException(e, stackTrace: stackTrace);
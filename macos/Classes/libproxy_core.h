@@ -12,6 +12,8 @@
 
 #ifndef GO_CGO_GOSTRING_TYPEDEF
 typedef struct { const char *p; ptrdiff_t n; } _GoString_;
+extern size_t _GoStringLen(_GoString_ s);
+extern const char *_GoStringPtr(_GoString_ s);
 #endif
 
 #endif
@@ -19,6 +21,17 @@ typedef struct { const char *p; ptrdiff_t n; } _GoString_;
 /* Start of preamble from import "C" comments.  */
 
 
+#line 3 "main.go"
+
+#include <stdlib.h>
+
+typedef int (*protect_fn)(int fd);
+
+static int call_protect(protect_fn fn, int fd) {
+	return fn(fd);
+}
+
+#line 1 "cgo-generated-wrapper"
 
 
 /* End of preamble from import "C" comments.  */
@@ -44,10 +57,16 @@ typedef size_t GoUintptr;
 typedef float GoFloat32;
 typedef double GoFloat64;
 #ifdef _MSC_VER
+#if !defined(__cplusplus) || _MSVC_LANG <= 201402L
 #include <complex.h>
 typedef _Fcomplex GoComplex64;
 typedef _Dcomplex GoComplex128;
 #else
+#include <complex>
+typedef std::complex<float> GoComplex64;
+typedef std::complex<double> GoComplex128;
+#endif
+#else
 typedef float _Complex GoComplex64;
 typedef double _Complex GoComplex128;
 #endif
@@ -74,8 +93,14 @@ typedef struct { void *data; GoInt len; GoInt cap; } GoSlice;
 extern "C" {
 #endif
 
-extern void GRPCSERVER();
-extern void ENFORCE_BINDING();
+extern char* GRPCSERVER(void);
+extern char* GRPCSERVER_LISTEN(char* listen);
+extern char* GRPCSERVER_TOKEN(void);
+extern GoUint8 GRPCSERVER_STOP(void);
+extern char* GRPCSERVER_RESTART(void);
+extern void FREE_STRING(char* s);
+extern void SET_PROTECT_CALLBACK(protect_fn fn);
+extern void ENFORCE_BINDING(void);
 
 #ifdef __cplusplus
 }
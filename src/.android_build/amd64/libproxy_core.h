@@ -21,6 +21,17 @@ extern const char *_GoStringPtr(_GoString_ s);
 /* Start of preamble from import "C" comments.  */
 
 
+#line 3 "main.go"
+
+#include <stdlib.h>
+
+typedef int (*protect_fn)(int fd);
+
+static int call_protect(protect_fn fn, int fd) {
+	return fn(fd);
+}
+
+#line 1 "cgo-generated-wrapper"
 
 
 /* End of preamble from import "C" comments.  */
@@ -82,7 +93,13 @@ typedef struct { void *data; GoInt len; GoInt cap; } GoSlice;
 extern "C" {
 #endif
 
-extern GoUint8 GRPCSERVER(void);
+extern char* GRPCSERVER(void);
+extern char* GRPCSERVER_LISTEN(char* listen);
+extern char* GRPCSERVER_TOKEN(void);
+extern GoUint8 GRPCSERVER_STOP(void);
+extern char* GRPCSERVER_RESTART(void);
+extern void FREE_STRING(char* s);
+extern void SET_PROTECT_CALLBACK(protect_fn fn);
 extern void ENFORCE_BINDING(void);
 
 #ifdef __cplusplus
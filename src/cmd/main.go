@@ -1,20 +1,112 @@
 package main
 
+/*
+#include <stdlib.h>
+
+typedef int (*protect_fn)(int fd);
+
+static int call_protect(protect_fn fn, int fd) {
+	return fn(fd);
+}
+*/
 import "C"
 import (
+	"fmt"
+	"unsafe"
+
+	"segment/global"
 	Sserver "segment/server"
 )
 
+// GRPCSERVER starts the gRPC server on the default address and returns the
+// token clients must send in the "x-proxy-core-token" metadata header, or
+// "ERROR_SERVER: <error>". Free the result with FREE_STRING.
+//
 //export GRPCSERVER
-func GRPCSERVER() bool {
-	return Sserver.StartGRPCServer()
+func GRPCSERVER() *C.char {
+	_, token, err := Sserver.StartGRPCServer("")
+	if err != nil {
+		return C.CString("ERROR_SERVER: " + err.Error())
+	}
+	return C.CString(token)
+}
+
+// GRPCSERVER_LISTEN starts the gRPC server on the given listen spec
+// ("host:port", "127.0.0.1:0" or "unix:///path?mode=600") and returns the
+// bound address, or "ERROR_SERVER: <error>". Free the result with FREE_STRING.
+//
+//export GRPCSERVER_LISTEN
+func GRPCSERVER_LISTEN(listen *C.char) *C.char {
+	addr, _, err := Sserver.StartGRPCServer(C.GoString(listen))
+	if err != nil {
+		return C.CString("ERROR_SERVER: " + err.Error())
+	}
+	return C.CString(addr)
+}
+
+// GRPCSERVER_TOKEN returns the token clients must send in the
+// "x-proxy-core-token" metadata header. It changes on every server start.
+// Free the result with FREE_STRING.
+//
+//export GRPCSERVER_TOKEN
+func GRPCSERVER_TOKEN() *C.char {
+	return C.CString(Sserver.AuthToken())
+}
+
+// GRPCSERVER_STOP stops the running core, tun2socks and the gRPC server.
+//
+//export GRPCSERVER_STOP
+func GRPCSERVER_STOP() bool {
+	return Sserver.StopGRPCServer(Sserver.DefaultShutdownTimeout) == nil
+}
+
+// GRPCSERVER_RESTART restarts the gRPC server on the same listen spec and
+// returns the new address, or "ERROR_SERVER: <error>" when it did not come
+// back up. A core that failed to stop is logged and does not keep the server
+// down. The token changes, fetch it again with GRPCSERVER_TOKEN. Free the
+// result with FREE_STRING.
+//
+//export GRPCSERVER_RESTART
+func GRPCSERVER_RESTART() *C.char {
+	addr, _, err := Sserver.RestartGRPCServer(Sserver.DefaultShutdownTimeout)
+	if addr == "" {
+		return C.CString("ERROR_SERVER: " + err.Error())
+	}
+	return C.CString(addr)
+}
+
+//export FREE_STRING
+func FREE_STRING(s *C.char) {
+	C.free(unsafe.Pointer(s))
+}
+
+// SET_PROTECT_CALLBACK registers fn, an int (*)(int fd), to be called on
+// every outbound socket of the cores before it connects. It must protect the
+// socket from the VPN, e.g. with VpnService.protect, and return non-zero on
+// success. Outline and tun bypass dials fail on zero; Xray logs it and goes
+// on. NULL removes the callback.
+//
+//export SET_PROTECT_CALLBACK
+func SET_PROTECT_CALLBACK(fn C.protect_fn) {
+	if fn == nil {
+		global.SetProtector(nil)
+		return
+	}
+	global.SetProtector(func(fd int) bool {
+		return C.call_protect(fn, C.int(fd)) != 0
+	})
 }
 
+// ENFORCE_BINDING is kept for older hosts. Sockets are bound through the
+// callback of SET_PROTECT_CALLBACK.
+//
 //export ENFORCE_BINDING
 func ENFORCE_BINDING() {
 }
 
 func main() {
-	GRPCSERVER()
+	if _, token, err := Sserver.StartGRPCServer(""); err == nil {
+		fmt.Printf("%s: %s\n", Sserver.AuthMetadataKey, token)
+	}
 	Sserver.WaitForServer()
 }
@@ -8,6 +8,7 @@ import (
 	"flag"
 	"fmt"
 	"log/slog"
+	"net/url"
 	"os"
 	"os/signal"
 	"strconv"
@@ -22,11 +23,18 @@ import (
 type cliFlags struct {
 	ConfigPath string
 	ConfigJSON string
+	Key        string
 	Server     string
 	Port       int
 	Password   string
 	Method     string
+	Prefix     string
+	Transport  string
 	LocalAddr  string
+	HTTPPort   int
+	Mixed      bool
+	User       string
+	Pass       string
 	Verbose    bool
 }
 
@@ -34,11 +42,18 @@ func main() {
 	flags := cliFlags{}
 	flag.StringVar(&flags.ConfigPath, "config", "config.json", "Config file path")
 	flag.StringVar(&flags.ConfigJSON, "json", "", "Raw JSON config")
+	flag.StringVar(&flags.Key, "key", "", "Outline access key (ss:// or ssconf://)")
 	flag.StringVar(&flags.Server, "server", "", "SS server address")
 	flag.IntVar(&flags.Port, "port", 2080, "SS server port")
 	flag.StringVar(&flags.Password, "password", "", "SS password")
 	flag.StringVar(&flags.Method, "method", "", "SS encryption method")
+	flag.StringVar(&flags.Prefix, "prefix", "", "SS connection prefix, percent-encoded as in access keys")
+	flag.StringVar(&flags.Transport, "transport", "", "Outline SDK transport config, used alone when no server is given")
 	flag.StringVar(&flags.LocalAddr, "local", "", "Local SOCKS address")
+	flag.IntVar(&flags.HTTPPort, "http", 0, "Local HTTP proxy port, 0 to disable")
+	flag.BoolVar(&flags.Mixed, "mixed", false, "Also accept HTTP proxy clients on the SOCKS port")
+	flag.StringVar(&flags.User, "user", "", "Username required by the local proxy")
+	flag.StringVar(&flags.Pass, "pass", "", "Password required by the local proxy")
 	flag.BoolVar(&flags.Verbose, "v", false, "Verbose mode")
 	flag.Parse()
 
@@ -51,8 +66,17 @@ func main() {
 		}(),
 	}))
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	cfg := liboutline.SSConfig{}
 	switch {
+	case flags.Key != "":
+		var err error
+		if cfg, err = liboutline.ParseAccessKey(ctx, flags.Key); err != nil {
+			log.Error("Parse access key", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
 	case flags.ConfigJSON != "":
 		_ = json.Unmarshal([]byte(flags.ConfigJSON), &cfg)
 	case flags.Server != "" && flags.Password != "" && flags.Method != "":
@@ -63,6 +87,16 @@ func main() {
 			Method:     flags.Method,
 			Verbose:    flags.Verbose,
 		}
+		if flags.Prefix != "" {
+			prefix, err := url.QueryUnescape(flags.Prefix)
+			if err != nil {
+				log.Error("Parse prefix", slog.String("error", err.Error()))
+				os.Exit(1)
+			}
+			cfg.Prefix = prefix
+		}
+	case flags.Transport != "":
+		// Transport-only mode, set below.
 	default:
 		data, err := os.ReadFile(flags.ConfigPath)
 		if err != nil {
@@ -72,6 +106,9 @@ func main() {
 		_ = json.Unmarshal(data, &cfg)
 	}
 
+	if flags.Transport != "" {
+		cfg.Transport = flags.Transport
+	}
 	if cfg.LocalAddr == "" {
 		cfg.LocalAddr = fmt.Sprintf("0.0.0.0:%d", flags.Port)
 	}
@@ -84,10 +121,12 @@ func main() {
 		Config:    string(raw),
 		IsString:  true,
 		ProxyPort: port,
+		HTTPPort:  int32(flags.HTTPPort),
+		Mixed:     flags.Mixed,
+	}
+	if flags.User != "" {
+		opts.Auth = &global.ProxyAuth{Username: flags.User, Password: flags.Pass}
 	}
-
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
 
 	service := liboutline.GetOutlineService()
 	if err := service.Start(ctx, opts); err != nil {
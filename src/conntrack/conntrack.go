@@ -0,0 +1,193 @@
+package conntrack
+
+import (
+	"net"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Metadata describes where a tracked connection comes from and goes to.
+type Metadata struct {
+	Core        string
+	Network     string
+	Source      string
+	Destination string
+	Inbound     string
+	Outbound    string
+
+	// OutboundFunc resolves the outbound lazily for cores that pick it
+	// only after the connection has been registered.
+	OutboundFunc func() string
+}
+
+// Entry is a live connection in the table.
+type Entry struct {
+	Metadata
+	ID    uint64
+	Start time.Time
+
+	uplink   atomic.Int64
+	downlink atomic.Int64
+	closer   func()
+	once     sync.Once
+}
+
+var (
+	mu      sync.Mutex
+	entries = make(map[uint64]*Entry)
+	nextID  uint64
+)
+
+// Add registers a connection. closer is invoked by Close to tear it down.
+func Add(meta Metadata, closer func()) *Entry {
+	mu.Lock()
+	defer mu.Unlock()
+
+	nextID++
+	e := &Entry{Metadata: meta, ID: nextID, Start: time.Now(), closer: closer}
+	entries[e.ID] = e
+	return e
+}
+
+// AddUplink records n bytes sent by the client.
+func (e *Entry) AddUplink(n int64) {
+	if n > 0 {
+		e.uplink.Add(n)
+	}
+}
+
+// AddDownlink records n bytes sent back to the client.
+func (e *Entry) AddDownlink(n int64) {
+	if n > 0 {
+		e.downlink.Add(n)
+	}
+}
+
+// OutboundTag returns the outbound handling the connection, if known yet.
+func (e *Entry) OutboundTag() string {
+	if e.OutboundFunc != nil {
+		if tag := e.OutboundFunc(); tag != "" {
+			return tag
+		}
+	}
+	return e.Outbound
+}
+
+// Uplink returns the bytes sent by the client so far.
+func (e *Entry) Uplink() int64 { return e.uplink.Load() }
+
+// Downlink returns the bytes sent back to the client so far.
+func (e *Entry) Downlink() int64 { return e.downlink.Load() }
+
+// Done removes the entry from the table once the connection has ended.
+func (e *Entry) Done() {
+	mu.Lock()
+	delete(entries, e.ID)
+	mu.Unlock()
+}
+
+// Close tears the connection down and removes it from the table.
+func (e *Entry) Close() {
+	e.once.Do(func() {
+		if e.closer != nil {
+			e.closer()
+		}
+	})
+	e.Done()
+}
+
+// List returns the live connections ordered by start.
+func List() []*Entry {
+	mu.Lock()
+	out := make([]*Entry, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, e)
+	}
+	mu.Unlock()
+
+	slices.SortFunc(out, func(a, b *Entry) int {
+		switch {
+		case a.ID < b.ID:
+			return -1
+		case a.ID > b.ID:
+			return 1
+		}
+		return 0
+	})
+	return out
+}
+
+// Count returns the number of live connections of core.
+func Count(core string) int {
+	mu.Lock()
+	defer mu.Unlock()
+	n := 0
+	for _, e := range entries {
+		if e.Core == core {
+			n++
+		}
+	}
+	return n
+}
+
+// Close closes the connection with the given id. It reports whether it was found.
+func Close(id uint64) bool {
+	mu.Lock()
+	e, ok := entries[id]
+	mu.Unlock()
+
+	if ok {
+		e.Close()
+	}
+	return ok
+}
+
+// CloseAll closes every connection opened by core.
+func CloseAll(core string) {
+	for _, e := range List() {
+		if e.Core == core {
+			e.Close()
+		}
+	}
+}
+
+// TrackConn registers c and returns a wrapper that counts its traffic and
+// leaves the table when closed. Writes are counted as uplink.
+func TrackConn(meta Metadata, c net.Conn) net.Conn {
+	tc := &trackedConn{Conn: c}
+	tc.entry = Add(meta, func() { c.Close() })
+	return tc
+}
+
+type trackedConn struct {
+	net.Conn
+	entry *Entry
+}
+
+func (c *trackedConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.entry.AddDownlink(int64(n))
+	return n, err
+}
+
+func (c *trackedConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	c.entry.AddUplink(int64(n))
+	return n, err
+}
+
+// CloseWrite keeps half-close working for stream connections.
+func (c *trackedConn) CloseWrite() error {
+	if cw, ok := c.Conn.(interface{ CloseWrite() error }); ok {
+		return cw.CloseWrite()
+	}
+	return nil
+}
+
+func (c *trackedConn) Close() error {
+	err := c.Conn.Close()
+	c.entry.Done()
+	return err
+}
@@ -0,0 +1,116 @@
+package corestate
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+)
+
+// State is a lifecycle phase of a core or of the tunnel.
+type State int
+
+const (
+	Stopped State = iota
+	Starting
+	Running
+	Stopping
+	Failed
+)
+
+// Tun is the component name used by libtun.
+const Tun = "tun"
+
+func (s State) String() string {
+	switch s {
+	case Starting:
+		return "starting"
+	case Running:
+		return "running"
+	case Stopping:
+		return "stopping"
+	case Failed:
+		return "failed"
+	default:
+		return "stopped"
+	}
+}
+
+// Event describes a single state transition of a component.
+type Event struct {
+	Seq       uint64
+	Time      time.Time
+	Component string
+	State     State
+	Err       string
+}
+
+const backlog = 256
+
+var (
+	mu      sync.Mutex
+	events  = make([]Event, backlog) // ring buffer indexed by Seq % backlog
+	next    uint64
+	current = make(map[string]Event)
+	notify  = make(chan struct{})
+)
+
+// Publish records a transition of component and wakes up every watcher.
+// A non-nil err is attached to the event, which is expected for Failed.
+func Publish(component string, state State, err error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	e := Event{
+		Seq:       next,
+		Time:      time.Now(),
+		Component: component,
+		State:     state,
+	}
+	if err != nil {
+		e.Err = err.Error()
+	}
+	events[next%backlog] = e
+	current[component] = e
+	next++
+
+	close(notify)
+	notify = make(chan struct{})
+}
+
+// Snapshot returns the latest event of every known component and the cursor
+// to follow transitions published after it.
+func Snapshot() ([]Event, uint64) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	out := make([]Event, 0, len(current))
+	for _, e := range current {
+		out = append(out, e)
+	}
+	slices.SortFunc(out, func(a, b Event) int { return cmp.Compare(a.Seq, b.Seq) })
+	return out, next
+}
+
+// Since returns the events published from cursor on, the next cursor and a
+// channel that is closed once another event is published.
+func Since(cursor uint64) ([]Event, uint64, <-chan struct{}) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if next > backlog && cursor < next-backlog {
+		cursor = next - backlog
+	}
+	var out []Event
+	for seq := cursor; seq < next; seq++ {
+		out = append(out, events[seq%backlog])
+	}
+	return out, next, notify
+}
+
+// Current returns the latest known state of component.
+func Current(component string) State {
+	mu.Lock()
+	defer mu.Unlock()
+	return current[component].State
+}
@@ -0,0 +1,18 @@
+package global
+
+import (
+	"encoding/base64"
+	"strings"
+)
+
+// DecodeBase64 decodes the base64 found in share links, access keys and
+// subscriptions: standard or URL-safe alphabet, padded or not. Whitespace,
+// as in line-wrapped subscription bodies, is ignored.
+func DecodeBase64(s string) ([]byte, error) {
+	s = strings.Join(strings.Fields(s), "")
+	s = strings.TrimRight(s, "=")
+	if strings.ContainsAny(s, "-_") {
+		return base64.RawURLEncoding.DecodeString(s)
+	}
+	return base64.RawStdEncoding.DecodeString(s)
+}
@@ -1,5 +1,7 @@
 package global
 
+import "time"
+
 // Define a struct for Start options
 type StartOptions struct {
 	Dir       string
@@ -7,4 +9,23 @@ type StartOptions struct {
 	Memory    int64
 	IsString  bool
 	ProxyPort int32
+	HTTPPort  int32        // Extra HTTP proxy port, 0 for none
+	Mixed     bool         // ProxyPort takes HTTP proxy clients besides SOCKS5
+	Auth      *ProxyAuth   // Credentials the local inbounds require, nil for none
+	Pool      *PoolOptions // When set, the core is built from Pool.Servers instead of Config
+}
+
+// ProxyAuth is a username and password for the local proxy inbounds.
+type ProxyAuth struct {
+	Username string
+	Password string
+}
+
+// PoolOptions asks a core to spread traffic over several servers and switch
+// away from the ones that stop answering.
+type PoolOptions struct {
+	Servers       []string      // Core specific server configs, e.g. share links or outbound JSON
+	Strategy      string        // leastPing (default), leastLoad, roundRobin or random
+	ProbeURL      string        // Health check target
+	ProbeInterval time.Duration // Time between health checks
 }
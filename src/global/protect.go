@@ -0,0 +1,42 @@
+package global
+
+import (
+	"fmt"
+	"sync/atomic"
+	"syscall"
+)
+
+// Protector keeps an outbound socket out of the VPN, as Android's
+// VpnService.protect does, and reports whether it succeeded.
+type Protector func(fd int) bool
+
+var protector atomic.Pointer[Protector]
+
+// SetProtector registers the callback run on every outbound socket of the
+// cores. nil removes it.
+func SetProtector(p Protector) {
+	if p == nil {
+		protector.Store(nil)
+		return
+	}
+	protector.Store(&p)
+}
+
+// ProtectSocket is a net.Dialer Control function that hands the socket to
+// the registered Protector. Without one it does nothing.
+func ProtectSocket(network, address string, c syscall.RawConn) error {
+	p := protector.Load()
+	if p == nil {
+		return nil
+	}
+	ok := false
+	if err := c.Control(func(fd uintptr) {
+		ok = (*p)(int(fd))
+	}); err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("failed to protect %s socket to %s", network, address)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package global
+
+import (
+	"net"
+	"sync"
+	"syscall"
+	"testing"
+)
+
+// recorder is a Protector that remembers the sockets it was handed.
+type recorder struct {
+	mu  sync.Mutex
+	fds []int
+	ok  bool
+}
+
+func (r *recorder) protect(fd int) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fds = append(r.fds, fd)
+	return r.ok
+}
+
+func (r *recorder) calls() []int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]int(nil), r.fds...)
+}
+
+func listenLocal(t *testing.T) net.Listener {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			c.Close()
+		}
+	}()
+	return ln
+}
+
+func connFD(t *testing.T, c syscall.Conn) int {
+	t.Helper()
+	rc, err := c.SyscallConn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fd int
+	if err := rc.Control(func(s uintptr) { fd = int(s) }); err != nil {
+		t.Fatal(err)
+	}
+	return fd
+}
+
+func TestProtectSocket(t *testing.T) {
+	ln := listenLocal(t)
+	dialer := net.Dialer{Control: ProtectSocket}
+
+	t.Run("called with the socket", func(t *testing.T) {
+		r := &recorder{ok: true}
+		SetProtector(r.protect)
+		t.Cleanup(func() { SetProtector(nil) })
+
+		c, err := dialer.Dial("tcp", ln.Addr().String())
+		if err != nil {
+			t.Fatalf("dial: %v", err)
+		}
+		defer c.Close()
+		if got, want := r.calls(), connFD(t, c.(*net.TCPConn)); len(got) != 1 || got[0] != want {
+			t.Fatalf("protector calls = %v, want [%d]", got, want)
+		}
+	})
+
+	t.Run("refusal fails the dial", func(t *testing.T) {
+		r := &recorder{ok: false}
+		SetProtector(r.protect)
+		t.Cleanup(func() { SetProtector(nil) })
+
+		if c, err := dialer.Dial("tcp", ln.Addr().String()); err == nil {
+			c.Close()
+			t.Fatal("dial succeeded with a refusing protector")
+		}
+		if got := r.calls(); len(got) != 1 {
+			t.Fatalf("protector called %d times, want 1", len(got))
+		}
+	})
+
+	t.Run("nil removes it", func(t *testing.T) {
+		r := &recorder{ok: false}
+		SetProtector(r.protect)
+		SetProtector(nil)
+
+		c, err := dialer.Dial("tcp", ln.Addr().String())
+		if err != nil {
+			t.Fatalf("dial: %v", err)
+		}
+		c.Close()
+		if got := r.calls(); len(got) != 0 {
+			t.Fatalf("removed protector was called %d times", len(got))
+		}
+	})
+}
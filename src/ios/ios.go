@@ -11,17 +11,38 @@ import (
 
 	"segment/proxycoreproto"
 	"segment/server"
+
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 var (
 	lastCPUTime time.Duration
 	lastCheck   time.Time
 	cpuMutex    sync.Mutex
+
+	watchMutex  sync.Mutex
+	watchCancel context.CancelFunc
 )
 
+// CoreStateListener is implemented on the Swift side to receive state transitions.
+type CoreStateListener interface {
+	OnCoreStateChanged(component string, state string, err string)
+}
+
+// TestConfigsListener receives TestConfigResult messages as JSON while TestConfigsIOS runs.
+type TestConfigsListener interface {
+	OnTestConfigResult(resultJSON string)
+}
+
 // StartGRPCIOS starts the gRPC server used by Flutter+iOS.
 func StartGRPCIOS() bool {
-	return server.StartGRPCServer()
+	_, _, err := server.StartGRPCServer("")
+	return err == nil
+}
+
+// StopGRPCIOS stops the running core and the gRPC server.
+func StopGRPCIOS() bool {
+	return server.StopGRPCServer(server.DefaultShutdownTimeout) == nil
 }
 
 // StartCoreIOS starts a specified core with given config.
@@ -46,6 +67,27 @@ func StartCoreIOS(coreName string, dir string, config string, memory int32, isSt
 	return "true"
 }
 
+// ReloadCoreIOS switches the running core to a new config without a restart.
+// Returns "true" on success or "ERROR_CORE:<error>".
+func ReloadCoreIOS(coreName string, dir string, config string, memory int32, isString bool, proxyPort int32) string {
+	ctx := context.Background()
+
+	req := &proxycoreproto.StartCoreRequest{
+		CoreName:  coreName,
+		Dir:       dir,
+		Config:    config,
+		Memory:    memory,
+		IsString:  isString,
+		ProxyPort: proxyPort,
+	}
+
+	_, err := server.HandleReloadCore(ctx, req)
+	if err != nil {
+		return "ERROR_CORE: " + err.Error()
+	}
+	return "true"
+}
+
 // StopCoreIOS stops the currently running core.
 func StopCoreIOS() bool {
 	ctx := context.Background()
@@ -98,6 +140,77 @@ func MeasurePingIOS(urls string) string {
 	return strings.Join(delays, ",")
 }
 
+// GetTrafficStatsIOS returns "uplink,downlink,uplinkRate,downlinkRate,connections"
+// in bytes and bytes per second, optionally resetting the totals.
+func GetTrafficStatsIOS(reset bool) string {
+	ctx := context.Background()
+	resp, err := server.HandleGetTrafficStats(ctx, &proxycoreproto.TrafficStatsRequest{ResetCounters: reset})
+	if err != nil {
+		return err.Error()
+	}
+	return fmt.Sprintf("%d,%d,%d,%d,%d", resp.Uplink, resp.Downlink, resp.UplinkRate, resp.DownlinkRate, resp.Connections)
+}
+
+// ConvertShareLinkIOS converts a share link into an Xray config listening on proxyPort.
+// Returns the config JSON or "ERROR_CORE:<error>".
+func ConvertShareLinkIOS(link string, proxyPort int32) string {
+	ctx := context.Background()
+	resp, err := server.HandleConvertShareLink(ctx, &proxycoreproto.ConvertShareLinkRequest{Link: link, ProxyPort: proxyPort})
+	if err != nil {
+		return "ERROR_CORE: " + err.Error()
+	}
+	return resp.Config
+}
+
+// FetchSubscriptionIOS fetches and decodes a subscription.
+// Returns the FetchSubscriptionResponse as JSON or "ERROR_CORE:<error>".
+func FetchSubscriptionIOS(url string, viaCore bool, userAgent string) string {
+	ctx := context.Background()
+	resp, err := server.HandleFetchSubscription(ctx, &proxycoreproto.FetchSubscriptionRequest{
+		Url:       url,
+		ViaCore:   viaCore,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		return "ERROR_CORE: " + err.Error()
+	}
+	out, err := protojson.Marshal(resp)
+	if err != nil {
+		return "ERROR_CORE: " + err.Error()
+	}
+	return string(out)
+}
+
+// GetOutboundStatusIOS returns the OutboundStatusResponse of the active core as JSON
+// or "ERROR_CORE:<error>".
+func GetOutboundStatusIOS() string {
+	ctx := context.Background()
+	resp, err := server.HandleGetOutboundStatus(ctx, &proxycoreproto.Empty{})
+	if err != nil {
+		return "ERROR_CORE: " + err.Error()
+	}
+	out, err := protojson.Marshal(resp)
+	if err != nil {
+		return "ERROR_CORE: " + err.Error()
+	}
+	return string(out)
+}
+
+// GetTunStatsIOS returns the TunStatsResponse of the VPN tunnel as JSON
+// or "ERROR_CORE:<error>".
+func GetTunStatsIOS() string {
+	ctx := context.Background()
+	resp, err := server.HandleGetTunStats(ctx, &proxycoreproto.Empty{})
+	if err != nil {
+		return "ERROR_CORE: " + err.Error()
+	}
+	out, err := protojson.Marshal(resp)
+	if err != nil {
+		return "ERROR_CORE: " + err.Error()
+	}
+	return string(out)
+}
+
 // FetchLogsIOS returns logs from the active core.
 func FetchLogsIOS() string {
 	ctx := context.Background()
@@ -114,6 +227,54 @@ func ClearLogsIOS() {
 	_, _ = server.HandleClearLogs(ctx, &proxycoreproto.Empty{})
 }
 
+// WatchCoreStateIOS delivers state transitions of the active core and tun2socks
+// to listener until StopWatchingCoreStateIOS is called.
+func WatchCoreStateIOS(listener CoreStateListener) {
+	StopWatchingCoreStateIOS()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	watchMutex.Lock()
+	watchCancel = cancel
+	watchMutex.Unlock()
+
+	go server.HandleWatchCoreState(ctx, func(e *proxycoreproto.CoreStateEvent) error {
+		state := strings.ToLower(strings.TrimPrefix(e.State.String(), "CORE_STATE_"))
+		listener.OnCoreStateChanged(e.Component, state, e.Error)
+		return nil
+	})
+}
+
+// StopWatchingCoreStateIOS stops the listener registered by WatchCoreStateIOS.
+func StopWatchingCoreStateIOS() {
+	watchMutex.Lock()
+	defer watchMutex.Unlock()
+	if watchCancel != nil {
+		watchCancel()
+		watchCancel = nil
+	}
+}
+
+// TestConfigsIOS tests the configs of a TestConfigsRequest given as JSON and
+// blocks until all results were delivered. Returns "true" or "ERROR_CORE:<error>".
+func TestConfigsIOS(requestJSON string, listener TestConfigsListener) string {
+	req := &proxycoreproto.TestConfigsRequest{}
+	if err := protojson.Unmarshal([]byte(requestJSON), req); err != nil {
+		return "ERROR_CORE: " + err.Error()
+	}
+	err := server.HandleTestConfigs(context.Background(), req, func(r *proxycoreproto.TestConfigResult) error {
+		out, err := protojson.Marshal(r)
+		if err != nil {
+			return err
+		}
+		listener.OnTestConfigResult(string(out))
+		return nil
+	})
+	if err != nil {
+		return "ERROR_CORE: " + err.Error()
+	}
+	return "true"
+}
+
 // GetMemoryUsageIOS returns the current memory usage of the app in bytes as a string.
 func GetMemoryUsageIOS() string {
 	var m runtime.MemStats
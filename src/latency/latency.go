@@ -0,0 +1,215 @@
+package latency
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"segment/proxycoreproto"
+)
+
+// DefaultURL is probed when the caller gives no URL.
+const DefaultURL = "https://www.google.com/generate_204"
+
+// DefaultTimeout bounds a single probe.
+const DefaultTimeout = 10 * time.Second
+
+// DialFunc opens a stream to addr through the proxy under test.
+type DialFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// Result holds the timings of one probe. Phases that did not run are zero.
+type Result struct {
+	URL       string
+	DNS       time.Duration // Local lookups made while dialing, e.g. of the proxy server
+	Connect   time.Duration // Until the proxy returned a stream to the target
+	TLS       time.Duration // TLS handshake with the target, through the proxy
+	HTTP      time.Duration // From sending the request to the first response byte
+	FirstByte time.Duration // From the start of the request to the first response byte
+	Total     time.Duration
+	Status    int
+	Category  proxycoreproto.PingErrorCategory
+	Err       error
+}
+
+// Measure issues a single GET for url over dial and times each phase.
+// Failures are reported in the result, never returned.
+func Measure(ctx context.Context, dial DialFunc, url string, timeout time.Duration) Result {
+	if url == "" {
+		url = DefaultURL
+	}
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	res := Result{URL: url}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var (
+		mu                  sync.Mutex
+		dnsStart, tlsStart  time.Time
+		wroteRequest, first time.Time
+	)
+	trace := &httptrace.ClientTrace{
+		// Only fire when the dialer resolves through the net package.
+		DNSStart: func(httptrace.DNSStartInfo) {
+			mu.Lock()
+			dnsStart = time.Now()
+			mu.Unlock()
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			mu.Lock()
+			if !dnsStart.IsZero() {
+				res.DNS += time.Since(dnsStart)
+			}
+			mu.Unlock()
+		},
+		TLSHandshakeStart: func() {
+			mu.Lock()
+			tlsStart = time.Now()
+			mu.Unlock()
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			mu.Lock()
+			if !tlsStart.IsZero() {
+				res.TLS = time.Since(tlsStart)
+			}
+			mu.Unlock()
+		},
+		WroteRequest: func(httptrace.WroteRequestInfo) {
+			mu.Lock()
+			wroteRequest = time.Now()
+			mu.Unlock()
+		},
+		GotFirstResponseByte: func() {
+			mu.Lock()
+			first = time.Now()
+			mu.Unlock()
+		},
+	}
+
+	tr := &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			start := time.Now()
+			conn, err := dial(ctx, network, addr)
+			mu.Lock()
+			res.Connect = time.Since(start)
+			mu.Unlock()
+			return conn, err
+		},
+		TLSHandshakeTimeout: timeout,
+		DisableKeepAlives:   true,
+	}
+	defer tr.CloseIdleConnections()
+
+	req, err := http.NewRequestWithContext(httptrace.WithClientTrace(ctx, trace), http.MethodGet, url, nil)
+	if err != nil {
+		res.Err = fmt.Errorf("invalid request for URL %s: %w", url, err)
+		res.Category = proxycoreproto.PingErrorCategory_PING_ERROR_OTHER
+		return res
+	}
+
+	start := time.Now()
+	resp, err := tr.RoundTrip(req)
+	res.Total = time.Since(start)
+
+	mu.Lock()
+	if !first.IsZero() {
+		res.FirstByte = first.Sub(start)
+		if !wroteRequest.IsZero() {
+			res.HTTP = first.Sub(wroteRequest)
+		}
+	}
+	mu.Unlock()
+
+	if err != nil {
+		res.Err = err
+		res.Category = Categorize(err)
+		return res
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	resp.Body.Close()
+
+	res.Status = resp.StatusCode
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		res.Err = fmt.Errorf("unexpected status code %d for URL %s", resp.StatusCode, url)
+		res.Category = proxycoreproto.PingErrorCategory_PING_ERROR_BAD_STATUS
+	}
+	return res
+}
+
+// Categorize maps a probe error onto the categories reported to the app.
+func Categorize(err error) proxycoreproto.PingErrorCategory {
+	var (
+		netErr     net.Error
+		dnsErr     *net.DNSError
+		recordErr  tls.RecordHeaderError
+		alertErr   tls.AlertError
+		verifyErr  *tls.CertificateVerificationError
+		unknownErr x509.UnknownAuthorityError
+		hostErr    x509.HostnameError
+	)
+	switch {
+	case err == nil:
+		return proxycoreproto.PingErrorCategory_PING_ERROR_NONE
+	case errors.As(err, &dnsErr):
+		if dnsErr.IsTimeout {
+			return proxycoreproto.PingErrorCategory_PING_ERROR_TIMEOUT
+		}
+		return proxycoreproto.PingErrorCategory_PING_ERROR_DNS
+	case errors.Is(err, context.DeadlineExceeded),
+		errors.As(err, &netErr) && netErr.Timeout():
+		return proxycoreproto.PingErrorCategory_PING_ERROR_TIMEOUT
+	case errors.Is(err, syscall.ECONNREFUSED), errors.Is(err, syscall.ECONNRESET),
+		// Xray closes the stream without a reason when its outbound cannot connect.
+		errors.Is(err, io.ErrClosedPipe):
+		return proxycoreproto.PingErrorCategory_PING_ERROR_REFUSED
+	case errors.As(err, &recordErr), errors.As(err, &alertErr), errors.As(err, &verifyErr),
+		errors.As(err, &unknownErr), errors.As(err, &hostErr):
+		return proxycoreproto.PingErrorCategory_PING_ERROR_TLS
+	}
+
+	// Cores often flatten errors into strings.
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "timeout"), strings.Contains(msg, "deadline exceeded"):
+		return proxycoreproto.PingErrorCategory_PING_ERROR_TIMEOUT
+	case strings.Contains(msg, "connection refused"), strings.Contains(msg, "connection reset"),
+		strings.Contains(msg, "closed pipe"):
+		return proxycoreproto.PingErrorCategory_PING_ERROR_REFUSED
+	case strings.Contains(msg, "tls:"), strings.Contains(msg, "x509:"):
+		return proxycoreproto.PingErrorCategory_PING_ERROR_TLS
+	case strings.Contains(msg, "no such host"):
+		return proxycoreproto.PingErrorCategory_PING_ERROR_DNS
+	}
+	return proxycoreproto.PingErrorCategory_PING_ERROR_OTHER
+}
+
+// PingResult converts the result into its wire form. Delay is -1 on failure.
+func (r Result) PingResult() *proxycoreproto.PingResult {
+	pr := &proxycoreproto.PingResult{
+		Url:            r.URL,
+		Delay:          r.Total.Milliseconds(),
+		DnsDelay:       r.DNS.Milliseconds(),
+		ConnectDelay:   r.Connect.Milliseconds(),
+		TlsDelay:       r.TLS.Milliseconds(),
+		FirstByteDelay: r.FirstByte.Milliseconds(),
+		StatusCode:     int32(r.Status),
+		ErrorCategory:  r.Category,
+	}
+	if r.Err != nil {
+		pr.Delay = -1
+		pr.Error = r.Err.Error()
+	}
+	return pr
+}
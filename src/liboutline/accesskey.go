@@ -0,0 +1,178 @@
+package liboutline
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"segment/global"
+)
+
+// dynamicKeyTimeout bounds fetching the online config of an ssconf:// key.
+const dynamicKeyTimeout = 15 * time.Second
+
+// isAccessKey reports whether config is an ss:// or ssconf:// key rather than JSON.
+func isAccessKey(config string) bool {
+	config = strings.TrimSpace(config)
+	return strings.HasPrefix(config, "ss://") || strings.HasPrefix(config, "ssconf://")
+}
+
+// ParseAccessKey decodes an Outline access key. Static keys are SIP002
+// ss:// URLs; dynamic ssconf:// keys are fetched over HTTPS and may hold
+// either an online config document or another ss:// key.
+func ParseAccessKey(ctx context.Context, key string) (SSConfig, error) {
+	key = strings.TrimSpace(key)
+	switch {
+	case strings.HasPrefix(key, "ss://"):
+		return parseStaticKey(key)
+	case strings.HasPrefix(key, "ssconf://"):
+		return fetchDynamicKey(ctx, key)
+	}
+	return SSConfig{}, errors.New("access key must start with ss:// or ssconf://")
+}
+
+// parseStaticKey decodes a SIP002 key, ss://userinfo@host:port/?plugin=...#name,
+// where userinfo is base64 or percent-encoded method:password. The legacy
+// form with the whole method:password@host:port base64 encoded is accepted too.
+func parseStaticKey(key string) (SSConfig, error) {
+	var cfg SSConfig
+
+	body, _, _ := strings.Cut(strings.TrimPrefix(key, "ss://"), "#")
+	body, rawQuery, _ := strings.Cut(body, "?")
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return cfg, fmt.Errorf("invalid access key: %w", err)
+	}
+
+	var userinfo, hostport string
+	if i := strings.LastIndexByte(body, '@'); i >= 0 {
+		u, err := url.Parse("ss://" + body)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid access key: %w", err)
+		}
+		userinfo, hostport = u.User.Username(), u.Host
+		if password, ok := u.User.Password(); ok {
+			// Percent-encoded method:password, used by the 2022 ciphers.
+			userinfo += ":" + password
+		} else if decoded, err := global.DecodeBase64(userinfo); err == nil {
+			userinfo = string(decoded)
+		}
+	} else {
+		raw, err := global.DecodeBase64(strings.TrimSuffix(body, "/"))
+		if err != nil {
+			return cfg, fmt.Errorf("invalid access key: %w", err)
+		}
+		decoded := string(raw)
+		// The password may itself contain '@'.
+		i := strings.LastIndexByte(decoded, '@')
+		if i < 0 {
+			return cfg, errors.New("invalid access key: missing server address")
+		}
+		userinfo, hostport = decoded[:i], decoded[i+1:]
+	}
+
+	method, password, ok := strings.Cut(userinfo, ":")
+	if !ok || method == "" || password == "" {
+		return cfg, errors.New("invalid access key: user info must be method:password")
+	}
+	host, portStr, err := net.SplitHostPort(strings.TrimSuffix(hostport, "/"))
+	if err != nil {
+		return cfg, fmt.Errorf("invalid access key: %w", err)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil || host == "" {
+		return cfg, errors.New("invalid access key: missing server address")
+	}
+
+	if plugin := query.Get("plugin"); plugin != "" {
+		name, _, _ := strings.Cut(plugin, ";")
+		return cfg, fmt.Errorf("shadowsocks plugin %q is not supported", name)
+	}
+
+	cfg = SSConfig{
+		Server:     host,
+		ServerPort: port,
+		Password:   password,
+		Method:     method,
+		Prefix:     query.Get("prefix"),
+	}
+	return cfg, nil
+}
+
+// onlineConfig is the document served for an ssconf:// key.
+type onlineConfig struct {
+	SSConfig
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// fetchDynamicKey downloads the online config an ssconf:// key points to.
+// The key's fragment only names the server and is not sent.
+func fetchDynamicKey(ctx context.Context, key string) (SSConfig, error) {
+	var cfg SSConfig
+
+	u, err := url.Parse(key)
+	if err != nil {
+		return cfg, fmt.Errorf("invalid dynamic key: %w", err)
+	}
+	u.Scheme = "https"
+	u.Fragment = ""
+
+	ctx, cancel := context.WithTimeout(ctx, dynamicKeyTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return cfg, fmt.Errorf("invalid dynamic key: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return cfg, fmt.Errorf("fetch dynamic key: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return cfg, fmt.Errorf("fetch dynamic key: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return cfg, fmt.Errorf("fetch dynamic key: unexpected status code %d", resp.StatusCode)
+	}
+
+	text := strings.TrimSpace(string(body))
+	if strings.HasPrefix(text, "ss://") {
+		return parseStaticKey(text)
+	}
+	var doc onlineConfig
+	if err := json.Unmarshal([]byte(text), &doc); err != nil {
+		return cfg, fmt.Errorf("invalid online config: %w", err)
+	}
+	if doc.Error != nil {
+		return cfg, fmt.Errorf("online config error: %s", doc.Error.Message)
+	}
+	if err := doc.SSConfig.validate(); err != nil {
+		return cfg, fmt.Errorf("invalid online config: %w", err)
+	}
+	return doc.SSConfig, nil
+}
+
+// decodePrefix turns a prefix as written in access keys and online configs
+// into bytes. Keys percent-encode the UTF-8 form of the characters, so
+// "%16%03%01%00%C2%A8" stands for the bytes 16 03 01 00 a8.
+func decodePrefix(prefix string) ([]byte, error) {
+	out := make([]byte, 0, len(prefix))
+	for _, r := range prefix {
+		if r > 0xFF {
+			return nil, fmt.Errorf("invalid prefix: character %U is not a byte", r)
+		}
+		out = append(out, byte(r))
+	}
+	return out, nil
+}
@@ -0,0 +1,274 @@
+package liboutline
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"segment/conntrack"
+	"segment/global"
+)
+
+// peekTimeout bounds the wait for the first byte on a mixed port.
+const peekTimeout = 10 * time.Second
+
+func trackConn(core, inbound, outbound, network, addr, source string, conn net.Conn) net.Conn {
+	return conntrack.TrackConn(conntrack.Metadata{
+		Core:        core,
+		Network:     network,
+		Source:      source,
+		Destination: addr,
+		Inbound:     inbound,
+		Outbound:    outbound,
+	}, conn)
+}
+
+// checkInbounds rejects reloading onto other listener settings. Reload only
+// swaps the dialers, so the listeners keep their ports and credentials.
+func checkInbounds(started, reload global.StartOptions) error {
+	if reload.ProxyPort != started.ProxyPort || reload.HTTPPort != started.HTTPPort || reload.Mixed != started.Mixed {
+		return errors.New("cannot reload onto other proxy ports, restart the core instead")
+	}
+	if (reload.Auth == nil) != (started.Auth == nil) || (reload.Auth != nil && *reload.Auth != *started.Auth) {
+		return errors.New("cannot reload with other proxy credentials, restart the core instead")
+	}
+	return nil
+}
+
+// sourceKey carries the client address of a forwarded HTTP request to the dialer.
+type sourceKey struct{}
+
+// newHTTPProxy returns a handler serving HTTP proxy requests through the
+// current dialers: CONNECT is tunneled, other requests are forwarded. With
+// auth set, requests must carry those credentials as Basic proxy auth.
+func newHTTPProxy(core, outbound string, current func() *ssDialers, logger *slog.Logger, auth *global.ProxyAuth) http.Handler {
+	dial := func(ctx context.Context, addr, source string) (net.Conn, error) {
+		conn, err := current().stream.DialStream(ctx, addr)
+		if err != nil {
+			logger.Info("connection failed", "network", "tcp", "target", addr, "error", err.Error())
+			return nil, err
+		}
+
+		logger.Info("connection established", "network", "tcp", "target", addr)
+
+		return trackConn(core, "http", outbound, "tcp", addr, source, conn), nil
+	}
+
+	forward := &http.Transport{
+		DialContext: func(ctx context.Context, _, addr string) (net.Conn, error) {
+			source, _ := ctx.Value(sourceKey{}).(string)
+			return dial(ctx, addr, source)
+		},
+		// Every client gets its own upstream connections.
+		DisableKeepAlives: true,
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth != nil && !proxyAuthorized(r, auth) {
+			w.Header().Set("Proxy-Authenticate", `Basic realm="proxy"`)
+			http.Error(w, "proxy authentication required", http.StatusProxyAuthRequired)
+			return
+		}
+		if r.Method == http.MethodConnect {
+			tunnel(w, r, dial)
+			return
+		}
+		if r.URL.Host == "" {
+			http.Error(w, "this is a proxy server", http.StatusBadRequest)
+			return
+		}
+
+		out := r.Clone(context.WithValue(r.Context(), sourceKey{}, r.RemoteAddr))
+		out.RequestURI = ""
+		out.Header.Del("Proxy-Connection")
+		out.Header.Del("Proxy-Authorization")
+		resp, err := forward.RoundTrip(out)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close()
+
+		for k, v := range resp.Header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(resp.StatusCode)
+		_, _ = io.Copy(w, resp.Body)
+	})
+}
+
+func proxyAuthorized(r *http.Request, auth *global.ProxyAuth) bool {
+	scheme, encoded, ok := strings.Cut(r.Header.Get("Proxy-Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Basic") {
+		return false
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return false
+	}
+	user, pass, ok := strings.Cut(string(decoded), ":")
+	return ok &&
+		subtle.ConstantTimeCompare([]byte(user), []byte(auth.Username)) == 1 &&
+		subtle.ConstantTimeCompare([]byte(pass), []byte(auth.Password)) == 1
+}
+
+func tunnel(w http.ResponseWriter, r *http.Request, dial func(context.Context, string, string) (net.Conn, error)) {
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "hijacking not supported", http.StatusInternalServerError)
+		return
+	}
+	target, err := dial(r.Context(), r.Host, r.RemoteAddr)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	client, buf, err := hj.Hijack()
+	if err != nil {
+		target.Close()
+		return
+	}
+	if _, err := io.WriteString(client, "HTTP/1.1 200 Connection established\r\n\r\n"); err != nil {
+		client.Close()
+		target.Close()
+		return
+	}
+	// The client may have sent data right behind the request.
+	if n := buf.Reader.Buffered(); n > 0 {
+		data, _ := buf.Reader.Peek(n)
+		if _, err := target.Write(data); err != nil {
+			client.Close()
+			target.Close()
+			return
+		}
+	}
+	relay(client, target)
+}
+
+// relay copies between both connections until both directions are done.
+func relay(left, right net.Conn) {
+	var wg sync.WaitGroup
+	copyHalf := func(dst, src net.Conn) {
+		defer wg.Done()
+		_, _ = io.Copy(dst, src)
+		if cw, ok := dst.(interface{ CloseWrite() error }); ok {
+			_ = cw.CloseWrite()
+		} else {
+			dst.Close()
+		}
+	}
+	wg.Add(2)
+	go copyHalf(left, right)
+	go copyHalf(right, left)
+	wg.Wait()
+	left.Close()
+	right.Close()
+}
+
+// mixedListener splits one listener into SOCKS and HTTP clients by the
+// first byte they send.
+type mixedListener struct {
+	parent net.Listener
+	socks  *subListener
+	http   *subListener
+	done   chan struct{}
+	once   sync.Once
+}
+
+func newMixedListener(parent net.Listener) *mixedListener {
+	m := &mixedListener{parent: parent, done: make(chan struct{})}
+	m.socks = &subListener{mixed: m, conns: make(chan net.Conn)}
+	m.http = &subListener{mixed: m, conns: make(chan net.Conn)}
+	go m.acceptLoop()
+	return m
+}
+
+func (m *mixedListener) acceptLoop() {
+	for {
+		conn, err := m.parent.Accept()
+		if err != nil {
+			m.close()
+			return
+		}
+		go m.dispatch(conn)
+	}
+}
+
+func (m *mixedListener) dispatch(conn net.Conn) {
+	r := bufio.NewReader(conn)
+	_ = conn.SetReadDeadline(time.Now().Add(peekTimeout))
+	first, err := r.Peek(1)
+	_ = conn.SetReadDeadline(time.Time{})
+	if err != nil {
+		conn.Close()
+		return
+	}
+
+	sub := m.http
+	if first[0] == 0x05 || first[0] == 0x04 {
+		sub = m.socks
+	}
+	select {
+	case sub.conns <- &peekedConn{Conn: conn, r: r}:
+	case <-m.done:
+		conn.Close()
+	}
+}
+
+func (m *mixedListener) close() {
+	m.once.Do(func() {
+		close(m.done)
+		m.parent.Close()
+	})
+}
+
+// subListener hands out the connections of one protocol. Closing it closes
+// the shared listener.
+type subListener struct {
+	mixed *mixedListener
+	conns chan net.Conn
+}
+
+func (l *subListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.mixed.done:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *subListener) Close() error {
+	l.mixed.close()
+	return nil
+}
+
+func (l *subListener) Addr() net.Addr {
+	return l.mixed.parent.Addr()
+}
+
+// peekedConn replays the bytes read while sniffing the protocol.
+type peekedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *peekedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+func (c *peekedConn) CloseWrite() error {
+	if cw, ok := c.Conn.(interface{ CloseWrite() error }); ok {
+		return cw.CloseWrite()
+	}
+	return c.Conn.Close()
+}
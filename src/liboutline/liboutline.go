@@ -9,13 +9,19 @@ import (
 	"net"
 	"net/http"
 	"net/netip"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
+	"segment/conntrack"
+	"segment/corestate"
 	"segment/global"
+	"segment/latency"
 	"segment/proxycoreproto"
+	"segment/traffic"
 
 	"github.com/Jigsaw-Code/outline-sdk/transport"
 	"github.com/Jigsaw-Code/outline-sdk/transport/shadowsocks"
@@ -30,6 +36,31 @@ type SSConfig struct {
 	Method     string `json:"method"`
 	Verbose    bool   `json:"verbose,omitempty"`
 	LocalAddr  string `json:"local_addr,omitempty"`
+	// Prefix starts the salt of every TCP connection to disguise it as
+	// another protocol. Each character encodes one byte (U+0000 to U+00FF).
+	Prefix string `json:"prefix,omitempty"`
+	// Transport is an outline-sdk transport config, e.g. "split:2|tlsfrag:1",
+	// that carries the connections to the server. Without a server, traffic
+	// goes to its destinations through the transport alone.
+	Transport string `json:"transport,omitempty"`
+}
+
+// transportOnly reports whether cfg uses a transport without a Shadowsocks server.
+func (cfg SSConfig) transportOnly() bool {
+	return cfg.Server == "" && cfg.Transport != ""
+}
+
+func (cfg SSConfig) validate() error {
+	if cfg.transportOnly() {
+		return nil
+	}
+	if cfg.Server == "" || cfg.ServerPort == 0 || cfg.Password == "" || cfg.Method == "" {
+		return errors.New("missing required config fields")
+	}
+	if _, err := decodePrefix(cfg.Prefix); err != nil {
+		return err
+	}
+	return nil
 }
 
 // logWriter captures logs in memory.
@@ -62,17 +93,28 @@ func (w *logWriter) Clear() {
 	w.mu.Unlock()
 }
 
+// ssDialers are the Shadowsocks dialers the SOCKS server relays through.
+// They are swapped as a whole on reload.
+type ssDialers struct {
+	server string  // host:port or transport, set for the dialers of a single server
+	pool   *ssPool // Set for the dialers the SOCKS server uses
+	stream transport.StreamDialer
+	packet transport.PacketListener
+}
+
 // OutlineService manages a Shadowsocks-based SOCKS5 proxy.
 type OutlineService struct {
-	mu               sync.Mutex
-	server           *socks5.Server
-	listener         net.Listener
-	ssStreamDialer   transport.StreamDialer
-	ssPacketListener transport.PacketListener
-	cancelFunc       context.CancelFunc
-	logWriter        *logWriter
-	logger           *slog.Logger
-	isRunning        bool
+	mu         sync.Mutex
+	server     *socks5.Server
+	listener   net.Listener
+	httpServer *http.Server // Set when an HTTP or mixed port is served
+	dialers    atomic.Pointer[ssDialers]
+	cancelFunc context.CancelFunc
+	logWriter  *logWriter
+	logger     *slog.Logger
+	meter      *traffic.Meter
+	inbounds   global.StartOptions // The options the listeners were started with
+	isRunning  bool
 }
 
 var (
@@ -83,7 +125,7 @@ var (
 // GetOutlineService returns the singleton instance.
 func GetOutlineService() *OutlineService {
 	outlineServiceOnce.Do(func() {
-		outlineService = &OutlineService{logWriter: &logWriter{}}
+		outlineService = &OutlineService{logWriter: &logWriter{}, meter: &traffic.Meter{}}
 	})
 	return outlineService
 }
@@ -93,8 +135,8 @@ func (osrv *OutlineService) CoreName() string {
 	return "outline"
 }
 
-// Start launches the proxy based on JSON config.
-func (osrv *OutlineService) Start(ctx context.Context, opts global.StartOptions) error {
+// Start launches the proxy based on JSON config or an Outline access key.
+func (osrv *OutlineService) Start(ctx context.Context, opts global.StartOptions) (err error) {
 	osrv.mu.Lock()
 	defer osrv.mu.Unlock()
 
@@ -102,23 +144,29 @@ func (osrv *OutlineService) Start(ctx context.Context, opts global.StartOptions)
 		return errors.New("proxy is already running")
 	}
 
+	corestate.Publish(osrv.CoreName(), corestate.Starting, nil)
+	defer func() {
+		if err != nil {
+			corestate.Publish(osrv.CoreName(), corestate.Failed, err)
+		}
+	}()
+
 	// Parse config
-	var cfg SSConfig
-	if err := json.Unmarshal([]byte(opts.Config), &cfg); err != nil {
-		return fmt.Errorf("invalid config JSON: %w", err)
-	}
-	if cfg.Server == "" || cfg.ServerPort == 0 || cfg.Password == "" || cfg.Method == "" {
-		return errors.New("missing required config fields")
+	cfgs, err := parseServers(ctx, opts)
+	if err != nil {
+		return err
 	}
-	osrv.initLogger()
+	osrv.logger = newLogger(osrv.CoreName(), osrv.logWriter)
 
-	// Setup Shadowsocks dialer
-	if err := osrv.initDialers(cfg); err != nil {
+	// Setup Shadowsocks dialers
+	dialers, err := osrv.newDialers(cfgs, opts.Pool)
+	if err != nil {
 		return err
 	}
+	osrv.dialers.Store(dialers)
 
 	// SOCKS5 server with custom dial
-	osrv.initSocksServer()
+	osrv.initSocksServer(opts.Auth)
 
 	// Prepare cancellation
 	serveCtx, cancel := context.WithCancel(ctx)
@@ -129,79 +177,273 @@ func (osrv *OutlineService) Start(ctx context.Context, opts global.StartOptions)
 	if err := osrv.initListener(serveCtx, addr); err != nil {
 		return err
 	}
+	socksListener, httpListeners, err := osrv.initHTTP(serveCtx, opts)
+	if err != nil {
+		osrv.listener.Close()
+		return err
+	}
 
+	osrv.inbounds = opts
+	osrv.meter.Reset()
 	osrv.isRunning = true
+	dialers.pool.start()
 
 	// Serve in background
-	go func(osrv *OutlineService) {
+	go func(server *socks5.Server, listener net.Listener) {
 		// Accept loop unblocks on listener.Close()
-		_ = osrv.server.Serve(osrv.listener)
-	}(osrv)
+		_ = server.Serve(listener)
+	}(osrv.server, socksListener)
+	for _, ln := range httpListeners {
+		go func(server *http.Server, listener net.Listener) {
+			_ = server.Serve(listener)
+		}(osrv.httpServer, ln)
+	}
 
 	osrv.logger.Info("proxy started", "address", addr.String())
+	corestate.Publish(osrv.CoreName(), corestate.Running, nil)
+	return nil
+}
+
+// Reload swaps in dialers for a new config behind the same SOCKS listener.
+// Connections opened before the swap keep using the old dialers until they close.
+// The listeners stay as they were started, so new ports or credentials are rejected.
+func (osrv *OutlineService) Reload(ctx context.Context, opts global.StartOptions) (err error) {
+	osrv.mu.Lock()
+	defer osrv.mu.Unlock()
+
+	if !osrv.isRunning {
+		return errors.New("proxy is not running")
+	}
+
+	corestate.Publish(osrv.CoreName(), corestate.Starting, nil)
+	defer func() {
+		if err != nil {
+			corestate.Publish(osrv.CoreName(), corestate.Failed, err)
+			if osrv.isRunning {
+				// The previous config is still serving.
+				corestate.Publish(osrv.CoreName(), corestate.Running, nil)
+			}
+		}
+	}()
+
+	if err := checkInbounds(osrv.inbounds, opts); err != nil {
+		return err
+	}
+	cfgs, err := parseServers(ctx, opts)
+	if err != nil {
+		return err
+	}
+	dialers, err := osrv.newDialers(cfgs, opts.Pool)
+	if err != nil {
+		return err
+	}
+	old := osrv.dialers.Swap(dialers)
+	dialers.pool.start()
+	if old != nil {
+		old.pool.Close()
+	}
+
+	osrv.logger.Info("proxy reloaded", "servers", len(cfgs))
+	corestate.Publish(osrv.CoreName(), corestate.Running, nil)
 	return nil
 }
 
-func (osrv *OutlineService) initDialers(cfg SSConfig) error {
+// initHTTP sets up the optional HTTP proxy inbounds: a mixed proxy port that
+// also takes HTTP clients, and a dedicated HTTP port. It returns the listener
+// left for SOCKS and the ones to serve HTTP on.
+func (osrv *OutlineService) initHTTP(ctx context.Context, opts global.StartOptions) (net.Listener, []net.Listener, error) {
+	osrv.httpServer = nil
+	if !opts.Mixed && opts.HTTPPort == 0 {
+		return osrv.listener, nil, nil
+	}
+
+	socksListener := osrv.listener
+	var httpListeners []net.Listener
+	if opts.HTTPPort != 0 {
+		addr := netip.AddrPortFrom(netip.MustParseAddr("127.0.0.1"), uint16(opts.HTTPPort))
+		ln, err := listenLocal(ctx, addr)
+		if err != nil {
+			return nil, nil, err
+		}
+		httpListeners = append(httpListeners, ln)
+		osrv.logger.Info("http proxy started", "address", addr.String())
+	}
+	if opts.Mixed {
+		mixed := newMixedListener(osrv.listener)
+		socksListener = mixed.socks
+		httpListeners = append(httpListeners, mixed.http)
+		osrv.listener = mixed.socks
+	}
+
+	osrv.httpServer = &http.Server{
+		Handler:           newHTTPProxy(osrv.CoreName(), "shadowsocks", osrv.dialers.Load, osrv.logger, opts.Auth),
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+	return socksListener, httpListeners, nil
+}
+
+// parseServers returns the servers to use: one per pool entry in pool mode,
+// otherwise Config holding a single SSConfig or a JSON array of them. Every
+// server may also be given as an ss:// or ssconf:// access key.
+func parseServers(ctx context.Context, opts global.StartOptions) ([]SSConfig, error) {
+	var configs []string
+	switch {
+	case opts.Pool != nil:
+		configs = opts.Pool.Servers
+	case strings.HasPrefix(strings.TrimSpace(opts.Config), "["):
+		var raw []json.RawMessage
+		if err := json.Unmarshal([]byte(opts.Config), &raw); err != nil {
+			return nil, fmt.Errorf("invalid config JSON: %w", err)
+		}
+		for _, r := range raw {
+			configs = append(configs, string(r))
+		}
+	default:
+		configs = []string{opts.Config}
+	}
+	if len(configs) == 0 {
+		return nil, errors.New("no servers configured")
+	}
+
+	cfgs := make([]SSConfig, 0, len(configs))
+	for i, config := range configs {
+		cfg, err := parseConfig(ctx, config)
+		if err != nil {
+			return nil, fmt.Errorf("server %d: %w", i, err)
+		}
+		cfgs = append(cfgs, cfg)
+	}
+	return cfgs, nil
+}
+
+func parseConfig(ctx context.Context, config string) (SSConfig, error) {
+	if isAccessKey(config) {
+		return ParseAccessKey(ctx, config)
+	}
+	var cfg SSConfig
+	if err := json.Unmarshal([]byte(config), &cfg); err != nil {
+		return cfg, fmt.Errorf("invalid config JSON: %w", err)
+	}
+	return cfg, cfg.validate()
+}
+
+func (osrv *OutlineService) newDialers(cfgs []SSConfig, pool *global.PoolOptions) (*ssDialers, error) {
+	p, err := newSSPool(cfgs, pool, osrv.logger)
+	if err != nil {
+		return nil, err
+	}
+	return &ssDialers{
+		pool:   p,
+		stream: &meteredStreamDialer{dialer: p, meter: osrv.meter},
+		packet: &meteredPacketListener{listener: p, meter: osrv.meter},
+	}, nil
+}
+
+// NewTestDialer builds Shadowsocks dialers for config without touching the
+// running proxy, for latency tests.
+func NewTestDialer(ctx context.Context, config string) (latency.DialFunc, error) {
+	cfg, err := parseConfig(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	d, err := newSSDialers(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, _, addr string) (net.Conn, error) {
+		return d.stream.DialStream(ctx, addr)
+	}, nil
+}
+
+func newSSDialers(cfg SSConfig) (*ssDialers, error) {
+	if cfg.transportOnly() {
+		return newTransportDialers(cfg)
+	}
+
 	key, err := shadowsocks.NewEncryptionKey(cfg.Method, cfg.Password)
 	if err != nil {
-		return fmt.Errorf("create encryption key: %w", err)
+		return nil, fmt.Errorf("create encryption key: %w", err)
+	}
+	baseStream, basePacket, err := newBaseDialers(cfg.Transport)
+	if err != nil {
+		return nil, err
 	}
+	server := net.JoinHostPort(cfg.Server, strconv.Itoa(cfg.ServerPort))
 
 	packetListener, err := shadowsocks.NewPacketListener(
-		transport.FuncPacketEndpoint(func(ctx context.Context) (net.Conn, error) {
-			d := &transport.UDPDialer{}
-			return d.DialPacket(ctx, fmt.Sprintf("%s:%d", cfg.Server, cfg.ServerPort))
-		}), key,
+		&transport.PacketDialerEndpoint{Dialer: basePacket, Address: server}, key,
 	)
 	if err != nil {
-		return fmt.Errorf("create shadowsocks packet listener: %w", err)
+		return nil, fmt.Errorf("create shadowsocks packet listener: %w", err)
 	}
-	osrv.ssPacketListener = packetListener
 
 	streamDialer, err := shadowsocks.NewStreamDialer(
-		transport.FuncStreamEndpoint(func(ctx context.Context) (transport.StreamConn, error) {
-			d := &transport.TCPDialer{}
-			return d.DialStream(ctx, fmt.Sprintf("%s:%d", cfg.Server, cfg.ServerPort))
-		}), key,
+		&transport.StreamDialerEndpoint{Dialer: baseStream, Address: server}, key,
 	)
 	if err != nil {
-		return fmt.Errorf("create shadowsocks stream dialer: %w", err)
+		return nil, fmt.Errorf("create shadowsocks stream dialer: %w", err)
+	}
+	if cfg.Prefix != "" {
+		// Outline servers only honor the prefix on TCP.
+		prefix, err := decodePrefix(cfg.Prefix)
+		if err != nil {
+			return nil, err
+		}
+		streamDialer.SaltGenerator = shadowsocks.NewPrefixSaltGenerator(prefix)
 	}
-	osrv.ssStreamDialer = streamDialer
 
-	return nil
+	return &ssDialers{
+		server: server,
+		stream: streamDialer,
+		packet: packetListener,
+	}, nil
+}
+
+func (osrv *OutlineService) initSocksServer(auth *global.ProxyAuth) {
+	osrv.server = newSocksServer(osrv.CoreName(), "shadowsocks", osrv.dialers.Load, osrv.logger, auth)
 }
 
-func (osrv *OutlineService) initSocksServer() {
-	tcpHandler := func(ctx context.Context, addr string) (net.Conn, error) {
-		conn, err := osrv.ssStreamDialer.DialStream(ctx, addr)
+// newSocksServer returns a SOCKS5 server relaying through the current
+// dialers. Connections are tracked under the given core and outbound names.
+// With auth set, clients must log in with those credentials.
+func newSocksServer(core, outbound string, current func() *ssDialers, logger *slog.Logger, auth *global.ProxyAuth) *socks5.Server {
+	tcpHandler := func(ctx context.Context, addr, source string) (net.Conn, error) {
+		conn, err := current().stream.DialStream(ctx, addr)
 		if err != nil {
-			osrv.logger.Info("connection failed", "network", "tcp", "target", addr, "error", err.Error())
+			logger.Info("connection failed", "network", "tcp", "target", addr, "error", err.Error())
 			return nil, err
 		}
 
-		osrv.logger.Info("connection established", "network", "tcp", "target", addr)
+		logger.Info("connection established", "network", "tcp", "target", addr)
 
-		return conn, nil
+		return trackConn(core, "socks", outbound, "tcp", addr, source, conn), nil
 	}
 
 	udpHandler := func(ctx context.Context, addr string) (net.Conn, error) {
-		conn, err := transport.PacketListenerDialer{Listener: osrv.ssPacketListener}.DialPacket(ctx, addr)
+		conn, err := transport.PacketListenerDialer{Listener: current().packet}.DialPacket(ctx, addr)
 		if err != nil {
-			osrv.logger.Info("connection failed", "network", "udp", "target", addr, "error", err.Error())
+			logger.Info("connection failed", "network", "udp", "target", addr, "error", err.Error())
 			return nil, err
 		}
 
-		osrv.logger.Info("connection established", "network", "udp", "target", addr)
+		logger.Info("connection established", "network", "udp", "target", addr)
 
-		return conn, nil
+		return trackConn(core, "socks", outbound, "udp", addr, "", conn), nil
 	}
 
 	opts := []socks5.Option{
+		// CONNECT goes through here so the client address can be tracked
+		socks5.WithDialAndRequest(func(ctx context.Context, network, addr string, req *socks5.Request) (net.Conn, error) {
+			source := ""
+			if req.RemoteAddr != nil {
+				source = req.RemoteAddr.String()
+			}
+			return tcpHandler(ctx, addr, source)
+		}),
+		// UDP ASSOCIATE only has the plain dial hook
 		socks5.WithDial(func(ctx context.Context, network, addr string) (net.Conn, error) {
 			if network == "tcp" {
-				return tcpHandler(ctx, addr)
+				return tcpHandler(ctx, addr, "")
 			}
 
 			if network == "udp" {
@@ -211,11 +453,28 @@ func (osrv *OutlineService) initSocksServer() {
 			return nil, fmt.Errorf("unknown network: %s", network)
 		}),
 	}
+	if auth != nil {
+		opts = append(opts, socks5.WithCredential(socks5.StaticCredentials{auth.Username: auth.Password}))
+	}
 
-	osrv.server = socks5.NewServer(opts...)
+	return socks5.NewServer(opts...)
 }
 
 func (osrv *OutlineService) initListener(ctx context.Context, addrPort netip.AddrPort) error {
+	listener, err := listenLocal(ctx, addrPort)
+	if err != nil {
+		return err
+	}
+
+	// Mark running before serving
+	osrv.listener = listener
+
+	return nil
+}
+
+// listenLocal opens the local proxy listener with SO_REUSEADDR so a restart
+// can rebind the port right away.
+func listenLocal(ctx context.Context, addrPort netip.AddrPort) (net.Listener, error) {
 	lc := net.ListenConfig{Control: func(_, _ string, r syscall.RawConn) error {
 		var serr error
 		r.Control(func(fd uintptr) {
@@ -226,13 +485,9 @@ func (osrv *OutlineService) initListener(ctx context.Context, addrPort netip.Add
 
 	listener, err := lc.Listen(ctx, "tcp", addrPort.String())
 	if err != nil {
-		return fmt.Errorf("listen error: %w", err)
+		return nil, fmt.Errorf("listen error: %w", err)
 	}
-
-	// Mark running before serving
-	osrv.listener = listener
-
-	return nil
+	return listener, nil
 }
 
 // Stop shuts down the proxy immediately.
@@ -245,6 +500,7 @@ func (osrv *OutlineService) Stop(ctx context.Context) error {
 	}
 	// Prevent new operations
 	osrv.isRunning = false
+	corestate.Publish(osrv.CoreName(), corestate.Stopping, nil)
 
 	// Cancel any pending dials
 	if osrv.cancelFunc != nil {
@@ -255,15 +511,23 @@ func (osrv *OutlineService) Stop(ctx context.Context) error {
 	if osrv.listener != nil {
 		osrv.listener.Close()
 	}
+	if osrv.httpServer != nil {
+		osrv.httpServer.Close()
+	}
+
+	conntrack.CloseAll(osrv.CoreName())
 
 	// Reset state
 	osrv.server = nil
 	osrv.listener = nil
-	osrv.ssStreamDialer = nil
-	osrv.ssPacketListener = nil
+	osrv.httpServer = nil
+	if dialers := osrv.dialers.Swap(nil); dialers != nil {
+		dialers.pool.Close()
+	}
 	osrv.cancelFunc = nil
 
 	osrv.logger.Info("proxy stopped")
+	corestate.Publish(osrv.CoreName(), corestate.Stopped, nil)
 	return nil
 }
 
@@ -285,38 +549,47 @@ func (osrv *OutlineService) ClearLogs() bool {
 	return true
 }
 
+// TrafficStats reports the bytes relayed through the Shadowsocks dialers.
+func (osrv *OutlineService) TrafficStats(reset bool) (*proxycoreproto.TrafficStatsResponse, error) {
+	if !osrv.IsRunning() {
+		return nil, errors.New("proxy is not running")
+	}
+	return osrv.meter.Snapshot(reset), nil
+}
+
+// OutboundStatus reports the health of every configured server and the
+// one new connections currently go to.
+func (osrv *OutlineService) OutboundStatus() (*proxycoreproto.OutboundStatusResponse, error) {
+	dialers := osrv.dialers.Load()
+	if dialers == nil {
+		return nil, errors.New("proxy is not running")
+	}
+	return dialers.pool.status(), nil
+}
+
 // MeasurePing performs HTTP GETs via the proxy.
 func (osrv *OutlineService) MeasurePing(ctx context.Context, urls []string) (*proxycoreproto.MeasurePingResponse, error) {
 	if ctx == nil || urls == nil {
 		return nil, errors.New("invalid parameters")
 	}
-	client := &http.Client{
-		Transport: &http.Transport{DialContext: func(dctx context.Context, network, addr string) (net.Conn, error) {
-			return osrv.ssStreamDialer.DialStream(dctx, addr)
-		}},
-		Timeout: 12 * time.Second,
+	dialers := osrv.dialers.Load()
+	if dialers == nil {
+		return nil, errors.New("proxy is not running")
 	}
-	results := make([]*proxycoreproto.PingResult, 0, len(urls))
-	for _, u := range urls {
-		url := u
-		if url == "" {
-			url = "https://www.google.com/generate_204"
-		}
-		start := time.Now()
-		resp, err := client.Get(url)
-		if err != nil || (resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent) {
-			results = append(results, &proxycoreproto.PingResult{Url: url, Delay: -1})
-			continue
-		}
-		resp.Body.Close()
-		d := time.Since(start).Milliseconds()
-		results = append(results, &proxycoreproto.PingResult{Url: url, Delay: d})
-		osrv.logger.Debug("ping", "url", url, "delay", d)
+	return measurePing(ctx, dialers.stream, urls, osrv.logger), nil
+}
+
+func measurePing(ctx context.Context, sd transport.StreamDialer, urls []string, logger *slog.Logger) *proxycoreproto.MeasurePingResponse {
+	dial := func(dctx context.Context, _, addr string) (net.Conn, error) {
+		return sd.DialStream(dctx, addr)
 	}
-	if len(results) == 0 {
-		return nil, errors.New("no results")
+	results := make([]*proxycoreproto.PingResult, 0, len(urls))
+	for _, url := range urls {
+		res := latency.Measure(ctx, dial, url, 12*time.Second)
+		results = append(results, res.PingResult())
+		logger.Debug("ping", "url", res.URL, "delay", res.Total.Milliseconds(), "error", res.Err)
 	}
-	return &proxycoreproto.MeasurePingResponse{Results: results}, nil
+	return &proxycoreproto.MeasurePingResponse{Results: results}
 }
 
 // Version returns the build version.
@@ -15,6 +15,8 @@ import (
 	"log/slog"
 	"os"
 	"unsafe"
+
+	"segment/logstream"
 )
 
 type androidLogWriter struct{}
@@ -26,11 +28,11 @@ func (androidLogWriter) Write(p []byte) (n int, err error) {
 	return len(p), nil
 }
 
-// initLogger sets up structured logging.
-func (osrv *OutlineService) initLogger() {
+// newLogger sets up structured logging for core, kept in lw for FetchLogs.
+func newLogger(core string, lw *logWriter) *slog.Logger {
 	level := slog.LevelDebug
 
-	w := io.MultiWriter(os.Stdout, osrv.logWriter, androidLogWriter{})
+	w := io.MultiWriter(os.Stdout, lw, androidLogWriter{})
 	h := slog.NewTextHandler(w, &slog.HandlerOptions{
 		Level: level,
 		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
@@ -41,5 +43,5 @@ func (osrv *OutlineService) initLogger() {
 			return a
 		},
 	})
-	osrv.logger = slog.New(h)
+	return slog.New(logstream.NewHandler(h, core))
 }
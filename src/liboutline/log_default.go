@@ -6,13 +6,15 @@ import (
 	"io"
 	"log/slog"
 	"os"
+
+	"segment/logstream"
 )
 
-// initLogger sets up structured logging.
-func (osrv *OutlineService) initLogger() {
+// newLogger sets up structured logging for core, kept in lw for FetchLogs.
+func newLogger(core string, lw *logWriter) *slog.Logger {
 	level := slog.LevelDebug
 
-	w := io.MultiWriter(os.Stdout, osrv.logWriter)
+	w := io.MultiWriter(os.Stdout, lw)
 	h := slog.NewTextHandler(w, &slog.HandlerOptions{Level: level})
-	osrv.logger = slog.New(h)
+	return slog.New(logstream.NewHandler(h, core))
 }
@@ -0,0 +1,72 @@
+package liboutline
+
+import (
+	"context"
+	"net"
+
+	"segment/traffic"
+
+	"github.com/Jigsaw-Code/outline-sdk/transport"
+)
+
+// meteredStreamDialer counts the payload of every stream it dials.
+type meteredStreamDialer struct {
+	dialer transport.StreamDialer
+	meter  *traffic.Meter
+}
+
+func (d *meteredStreamDialer) DialStream(ctx context.Context, addr string) (transport.StreamConn, error) {
+	conn, err := d.dialer.DialStream(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return &meteredStreamConn{StreamConn: conn, meter: d.meter}, nil
+}
+
+type meteredStreamConn struct {
+	transport.StreamConn
+	meter *traffic.Meter
+}
+
+func (c *meteredStreamConn) Read(b []byte) (int, error) {
+	n, err := c.StreamConn.Read(b)
+	c.meter.AddDownlink(n)
+	return n, err
+}
+
+func (c *meteredStreamConn) Write(b []byte) (int, error) {
+	n, err := c.StreamConn.Write(b)
+	c.meter.AddUplink(n)
+	return n, err
+}
+
+// meteredPacketListener counts the payload of every packet conn it creates.
+type meteredPacketListener struct {
+	listener transport.PacketListener
+	meter    *traffic.Meter
+}
+
+func (l *meteredPacketListener) ListenPacket(ctx context.Context) (net.PacketConn, error) {
+	conn, err := l.listener.ListenPacket(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &meteredPacketConn{PacketConn: conn, meter: l.meter}, nil
+}
+
+type meteredPacketConn struct {
+	net.PacketConn
+	meter *traffic.Meter
+}
+
+func (c *meteredPacketConn) ReadFrom(b []byte) (int, net.Addr, error) {
+	n, addr, err := c.PacketConn.ReadFrom(b)
+	c.meter.AddDownlink(n)
+	return n, addr, err
+}
+
+func (c *meteredPacketConn) WriteTo(b []byte, addr net.Addr) (int, error) {
+	n, err := c.PacketConn.WriteTo(b, addr)
+	c.meter.AddUplink(n)
+	return n, err
+}
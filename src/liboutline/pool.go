@@ -0,0 +1,257 @@
+package liboutline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"segment/global"
+	"segment/latency"
+	"segment/proxycoreproto"
+
+	"github.com/Jigsaw-Code/outline-sdk/transport"
+)
+
+const defaultProbeInterval = 30 * time.Second
+
+// ssServer is one Shadowsocks server of a pool and its last known health.
+type ssServer struct {
+	tag     string
+	dialers *ssDialers
+
+	mu       sync.Mutex
+	alive    bool
+	delay    time.Duration // Zero until a probe succeeded
+	lastErr  string
+	lastSeen time.Time
+	lastTry  time.Time
+}
+
+func (s *ssServer) healthy() (bool, time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.alive, s.delay
+}
+
+func (s *ssServer) succeeded(delay time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	s.alive, s.lastErr, s.lastSeen, s.lastTry = true, "", now, now
+	if delay > 0 {
+		s.delay = delay
+	}
+}
+
+func (s *ssServer) failed(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.alive, s.lastErr, s.lastTry = false, err.Error(), time.Now()
+}
+
+// ssPool spreads dials over several Shadowsocks servers. Servers count as
+// healthy until a probe or a dial fails, and a failed dial moves on to the
+// next candidate so sessions survive a server going down.
+type ssPool struct {
+	servers    []*ssServer
+	roundRobin bool
+	next       atomic.Uint32
+	probeURL   string
+	interval   time.Duration
+	logger     *slog.Logger
+	cancel     context.CancelFunc
+}
+
+func newSSPool(cfgs []SSConfig, pool *global.PoolOptions, logger *slog.Logger) (*ssPool, error) {
+	p := &ssPool{interval: defaultProbeInterval, logger: logger}
+	if pool != nil {
+		// Without per-server load figures leastLoad falls back to the
+		// lowest delay, and random to spreading dials in turn.
+		switch pool.Strategy {
+		case "", "leastPing", "leastLoad":
+		case "roundRobin", "random":
+			p.roundRobin = true
+		default:
+			return nil, fmt.Errorf("unknown pool strategy %q", pool.Strategy)
+		}
+		p.probeURL = pool.ProbeURL
+		if pool.ProbeInterval > 0 {
+			p.interval = pool.ProbeInterval
+		}
+	}
+
+	for i, cfg := range cfgs {
+		d, err := newSSDialers(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("server %d: %w", i, err)
+		}
+		p.servers = append(p.servers, &ssServer{tag: fmt.Sprintf("ss-%d", i), dialers: d, alive: true})
+	}
+	return p, nil
+}
+
+// start runs the health checker when there is more than one server to pick from.
+func (p *ssPool) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	if len(p.servers) < 2 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+		for {
+			p.probe(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Close stops the health checker. Open connections are not affected.
+func (p *ssPool) Close() {
+	if p.cancel != nil {
+		p.cancel()
+	}
+}
+
+func (p *ssPool) probe(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, s := range p.servers {
+		wg.Add(1)
+		go func(s *ssServer) {
+			defer wg.Done()
+			dial := func(ctx context.Context, _, addr string) (net.Conn, error) {
+				return s.dialers.stream.DialStream(ctx, addr)
+			}
+			res := latency.Measure(ctx, dial, p.probeURL, 0)
+			if ctx.Err() != nil {
+				return
+			}
+			if res.Err != nil {
+				s.failed(res.Err)
+				p.logger.Debug("health check failed", "server", s.dialers.server, "error", res.Err)
+				return
+			}
+			s.succeeded(res.Total)
+		}(s)
+	}
+	wg.Wait()
+}
+
+// candidates orders the servers for a dial: healthy ones first by the pool
+// strategy, then the unhealthy ones as a last resort. advance moves the
+// round-robin cursor.
+func (p *ssPool) candidates(advance bool) []*ssServer {
+	var healthy, unhealthy []*ssServer
+	for _, s := range p.servers {
+		if alive, _ := s.healthy(); alive {
+			healthy = append(healthy, s)
+		} else {
+			unhealthy = append(unhealthy, s)
+		}
+	}
+
+	if p.roundRobin && len(healthy) > 1 {
+		cursor := p.next.Load()
+		if advance {
+			cursor = p.next.Add(1)
+		}
+		n := int(cursor) % len(healthy)
+		healthy = append(healthy[n:], healthy[:n]...)
+	} else {
+		// Unmeasured servers sort after measured ones.
+		slices.SortStableFunc(healthy, func(a, b *ssServer) int {
+			_, da := a.healthy()
+			_, db := b.healthy()
+			switch {
+			case da == db:
+				return 0
+			case da == 0:
+				return 1
+			case db == 0:
+				return -1
+			case da < db:
+				return -1
+			}
+			return 1
+		})
+	}
+	return append(healthy, unhealthy...)
+}
+
+// dialPool tries the candidates in order until one connects.
+func dialPool[T any](ctx context.Context, p *ssPool, dial func(*ssServer) (T, error)) (T, error) {
+	var errs []error
+	for _, s := range p.candidates(true) {
+		conn, err := dial(s)
+		if err == nil {
+			s.succeeded(0)
+			return conn, nil
+		}
+		if ctx.Err() != nil {
+			return conn, err
+		}
+		s.failed(err)
+		p.logger.Warn("server failed, trying next", "server", s.dialers.server, "error", err)
+		errs = append(errs, err)
+	}
+	var zero T
+	if len(errs) == 0 {
+		return zero, errors.New("no servers configured")
+	}
+	return zero, errors.Join(errs...)
+}
+
+// DialStream implements transport.StreamDialer.
+func (p *ssPool) DialStream(ctx context.Context, addr string) (transport.StreamConn, error) {
+	return dialPool(ctx, p, func(s *ssServer) (transport.StreamConn, error) {
+		return s.dialers.stream.DialStream(ctx, addr)
+	})
+}
+
+// ListenPacket implements transport.PacketListener.
+func (p *ssPool) ListenPacket(ctx context.Context) (net.PacketConn, error) {
+	return dialPool(ctx, p, func(s *ssServer) (net.PacketConn, error) {
+		return s.dialers.packet.ListenPacket(ctx)
+	})
+}
+
+// status reports every server and the one the next dial would pick.
+func (p *ssPool) status() *proxycoreproto.OutboundStatusResponse {
+	resp := &proxycoreproto.OutboundStatusResponse{}
+	if candidates := p.candidates(false); len(candidates) > 0 {
+		resp.Selected = candidates[0].tag
+	}
+	for _, s := range p.servers {
+		s.mu.Lock()
+		health := &proxycoreproto.OutboundHealth{
+			Tag:   s.tag,
+			Name:  s.dialers.server,
+			Alive: s.alive,
+			Delay: s.delay.Milliseconds(),
+			Error: s.lastErr,
+		}
+		if !s.alive {
+			health.Delay = -1
+		}
+		if !s.lastSeen.IsZero() {
+			health.LastSeenTime = s.lastSeen.UnixMilli()
+		}
+		if !s.lastTry.IsZero() {
+			health.LastTryTime = s.lastTry.UnixMilli()
+		}
+		s.mu.Unlock()
+		resp.Outbounds = append(resp.Outbounds, health)
+	}
+	return resp
+}
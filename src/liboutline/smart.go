@@ -0,0 +1,331 @@
+package liboutline
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/netip"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"segment/conntrack"
+	"segment/corestate"
+	"segment/global"
+	"segment/proxycoreproto"
+	"segment/traffic"
+
+	"github.com/Jigsaw-Code/outline-sdk/x/smart"
+	"github.com/things-go/go-socks5"
+)
+
+// smartTestTimeout bounds each strategy test of the smart dialer.
+const smartTestTimeout = 5 * time.Second
+
+// SmartConfig documents the expected JSON fields of the smart core.
+type SmartConfig struct {
+	// Strategy is the smart dialer config with the dns, tls and fallback
+	// lists to try, as a JSON object or a YAML string.
+	Strategy    json.RawMessage `json:"strategy"`
+	TestDomains []string        `json:"test_domains"`
+}
+
+// winningStrategyKey is the cache key the smart dialer stores its winner under.
+const winningStrategyKey = "winning_strategy"
+
+// strategyCache keeps the winning strategy so a restart tries it first.
+type strategyCache struct {
+	mu     sync.Mutex
+	values map[string][]byte
+}
+
+func (c *strategyCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.values[key]
+	return v, ok
+}
+
+func (c *strategyCache) Put(key string, value []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if value == nil {
+		delete(c.values, key)
+		return
+	}
+	if c.values == nil {
+		c.values = make(map[string][]byte)
+	}
+	c.values[key] = value
+}
+
+// strategy returns the winning strategy as flow-style YAML.
+func (c *strategyCache) strategy() string {
+	v, _ := c.Get(winningStrategyKey)
+	return strings.TrimSpace(string(v))
+}
+
+// SmartService serves the outline-sdk smart dialer on a local SOCKS5 port.
+// It needs no proxy server: it searches for DNS and TLS settings that get
+// the test domains through, falling back to the configured proxies.
+type SmartService struct {
+	mu         sync.Mutex
+	server     *socks5.Server
+	listener   net.Listener
+	dialers    atomic.Pointer[ssDialers]
+	cancelFunc context.CancelFunc
+	logWriter  *logWriter
+	logger     *slog.Logger
+	meter      *traffic.Meter
+	cache      *strategyCache
+	inbounds   global.StartOptions // The options the listener was started with
+	isRunning  bool
+	isStarting bool // A strategy search for Start is under way
+}
+
+var (
+	smartService     *SmartService
+	smartServiceOnce sync.Once
+)
+
+// GetSmartService returns the singleton instance.
+func GetSmartService() *SmartService {
+	smartServiceOnce.Do(func() {
+		smartService = &SmartService{logWriter: &logWriter{}, meter: &traffic.Meter{}, cache: &strategyCache{}}
+	})
+	return smartService
+}
+
+// CoreName returns the service identifier.
+func (ss *SmartService) CoreName() string {
+	return "smart"
+}
+
+// Start searches for a working strategy and serves it on the proxy port.
+func (ss *SmartService) Start(ctx context.Context, opts global.StartOptions) (err error) {
+	ss.mu.Lock()
+	if ss.isRunning || ss.isStarting {
+		ss.mu.Unlock()
+		return errors.New("proxy is already running")
+	}
+	ss.isStarting = true
+	ss.logger = newLogger(ss.CoreName(), ss.logWriter)
+	ss.mu.Unlock()
+
+	corestate.Publish(ss.CoreName(), corestate.Starting, nil)
+	defer func() {
+		ss.mu.Lock()
+		ss.isStarting = false
+		ss.mu.Unlock()
+		if err != nil {
+			corestate.Publish(ss.CoreName(), corestate.Failed, err)
+		}
+	}()
+
+	// The search can take a while, so it runs without the lock to keep
+	// IsRunning and the stats responsive.
+	dialers, err := ss.newDialers(ctx, opts.Config)
+	if err != nil {
+		return err
+	}
+
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	serveCtx, cancel := context.WithCancel(ctx)
+	addr := netip.AddrPortFrom(netip.MustParseAddr("127.0.0.1"), uint16(opts.ProxyPort))
+	listener, err := listenLocal(serveCtx, addr)
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	ss.dialers.Store(dialers)
+	ss.server = newSocksServer(ss.CoreName(), "smart", ss.dialers.Load, ss.logger, opts.Auth)
+	ss.listener = listener
+	ss.cancelFunc = cancel
+	ss.inbounds = opts
+	ss.meter.Reset()
+	ss.isRunning = true
+	go func(server *socks5.Server, listener net.Listener) {
+		_ = server.Serve(listener)
+	}(ss.server, ss.listener)
+
+	ss.logger.Info("proxy started", "address", addr.String(), "strategy", dialers.server)
+	corestate.Publish(ss.CoreName(), corestate.Running, nil)
+	return nil
+}
+
+// Reload searches again with a new config and swaps the dialer behind the
+// same SOCKS listener, which keeps its port and credentials.
+func (ss *SmartService) Reload(ctx context.Context, opts global.StartOptions) (err error) {
+	ss.mu.Lock()
+	running, inbounds := ss.isRunning, ss.inbounds
+	ss.mu.Unlock()
+	if !running {
+		return errors.New("proxy is not running")
+	}
+
+	corestate.Publish(ss.CoreName(), corestate.Starting, nil)
+	defer func() {
+		if err != nil {
+			corestate.Publish(ss.CoreName(), corestate.Failed, err)
+			if ss.IsRunning() {
+				// The previous strategy is still serving.
+				corestate.Publish(ss.CoreName(), corestate.Running, nil)
+			}
+		}
+	}()
+
+	if err := checkInbounds(inbounds, opts); err != nil {
+		return err
+	}
+	// As in Start, the search runs without the lock.
+	dialers, err := ss.newDialers(ctx, opts.Config)
+	if err != nil {
+		return err
+	}
+
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	if !ss.isRunning {
+		return errors.New("proxy was stopped during the strategy search")
+	}
+	ss.dialers.Store(dialers)
+
+	ss.logger.Info("proxy reloaded", "strategy", dialers.server)
+	corestate.Publish(ss.CoreName(), corestate.Running, nil)
+	return nil
+}
+
+// newDialers runs the strategy search. The winning strategy is logged by
+// the finder and kept as the dialers' server name.
+func (ss *SmartService) newDialers(ctx context.Context, config string) (*ssDialers, error) {
+	var cfg SmartConfig
+	if err := json.Unmarshal([]byte(config), &cfg); err != nil {
+		return nil, fmt.Errorf("invalid config JSON: %w", err)
+	}
+	if len(cfg.Strategy) == 0 {
+		return nil, errors.New("missing strategy config")
+	}
+	if len(cfg.TestDomains) == 0 {
+		return nil, errors.New("missing test domains")
+	}
+	// JSON is valid YAML, so an object is passed through as is.
+	strategy := []byte(cfg.Strategy)
+	var text string
+	if json.Unmarshal(cfg.Strategy, &text) == nil {
+		strategy = []byte(text)
+	}
+
+	finder := &smart.StrategyFinder{
+		TestTimeout:  smartTestTimeout,
+		LogWriter:    ss.logWriter,
+		StreamDialer: newTCPDialer(),
+		PacketDialer: newUDPDialer(),
+		Cache:        ss.cache,
+	}
+	sd, err := finder.NewDialer(ctx, cfg.TestDomains, strategy)
+	if err != nil {
+		return nil, fmt.Errorf("no working strategy found: %w", err)
+	}
+
+	return &ssDialers{
+		server: ss.cache.strategy(),
+		stream: &meteredStreamDialer{dialer: sd, meter: ss.meter},
+		packet: &meteredPacketListener{listener: newUDPListener(), meter: ss.meter},
+	}, nil
+}
+
+// Stop shuts down the proxy immediately.
+func (ss *SmartService) Stop(ctx context.Context) error {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	if !ss.isRunning {
+		return nil
+	}
+	ss.isRunning = false
+	corestate.Publish(ss.CoreName(), corestate.Stopping, nil)
+
+	if ss.cancelFunc != nil {
+		ss.cancelFunc()
+	}
+	if ss.listener != nil {
+		ss.listener.Close()
+	}
+
+	conntrack.CloseAll(ss.CoreName())
+
+	ss.server = nil
+	ss.listener = nil
+	ss.dialers.Store(nil)
+	ss.cancelFunc = nil
+
+	ss.logger.Info("proxy stopped")
+	corestate.Publish(ss.CoreName(), corestate.Stopped, nil)
+	return nil
+}
+
+// IsRunning indicates proxy status.
+func (ss *SmartService) IsRunning() bool {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	return ss.isRunning
+}
+
+// FetchLogs retrieves in-memory logs, including the strategy search.
+func (ss *SmartService) FetchLogs() string {
+	return ss.logWriter.FetchLogs()
+}
+
+// ClearLogs empties stored logs.
+func (ss *SmartService) ClearLogs() bool {
+	ss.logWriter.Clear()
+	return true
+}
+
+// TrafficStats reports the bytes relayed through the smart dialer.
+func (ss *SmartService) TrafficStats(reset bool) (*proxycoreproto.TrafficStatsResponse, error) {
+	if !ss.IsRunning() {
+		return nil, errors.New("proxy is not running")
+	}
+	return ss.meter.Snapshot(reset), nil
+}
+
+// OutboundStatus reports the strategy the smart dialer settled on.
+func (ss *SmartService) OutboundStatus() (*proxycoreproto.OutboundStatusResponse, error) {
+	dialers := ss.dialers.Load()
+	if dialers == nil {
+		return nil, errors.New("proxy is not running")
+	}
+	return &proxycoreproto.OutboundStatusResponse{
+		Selected: "smart",
+		Outbounds: []*proxycoreproto.OutboundHealth{{
+			Tag:   "smart",
+			Name:  dialers.server,
+			Alive: true,
+		}},
+	}, nil
+}
+
+// MeasurePing performs HTTP GETs via the smart dialer.
+func (ss *SmartService) MeasurePing(ctx context.Context, urls []string) (*proxycoreproto.MeasurePingResponse, error) {
+	if ctx == nil || urls == nil {
+		return nil, errors.New("invalid parameters")
+	}
+	dialers := ss.dialers.Load()
+	if dialers == nil {
+		return nil, errors.New("proxy is not running")
+	}
+	return measurePing(ctx, dialers.stream, urls, ss.logger), nil
+}
+
+// Version returns the build version.
+func (ss *SmartService) Version() string {
+	return "Latest main branch"
+}
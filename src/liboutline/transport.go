@@ -0,0 +1,75 @@
+package liboutline
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"segment/global"
+
+	"github.com/Jigsaw-Code/outline-sdk/transport"
+	"github.com/Jigsaw-Code/outline-sdk/x/configurl"
+)
+
+// transportProviders parses outline-sdk transport configs such as
+// "split:2|tlsfrag:1" or "socks5://host:port". Every chain ends in the
+// protected base dialers.
+var transportProviders = newTransportProviders()
+
+func newTransportProviders() *configurl.ProviderContainer {
+	c := configurl.NewProviderContainer()
+	c.StreamDialers.BaseInstance = newTCPDialer()
+	c.PacketDialers.BaseInstance = newUDPDialer()
+	c.PacketListeners.BaseInstance = newUDPListener()
+	return configurl.RegisterDefaultProviders(c)
+}
+
+// newTCPDialer, newUDPDialer and newUDPListener open sockets that are
+// protected from the VPN when a protector is registered.
+func newTCPDialer() *transport.TCPDialer {
+	return &transport.TCPDialer{Dialer: net.Dialer{Control: global.ProtectSocket}}
+}
+
+func newUDPDialer() *transport.UDPDialer {
+	return &transport.UDPDialer{Dialer: net.Dialer{Control: global.ProtectSocket}}
+}
+
+func newUDPListener() *transport.UDPListener {
+	return &transport.UDPListener{ListenConfig: net.ListenConfig{Control: global.ProtectSocket}}
+}
+
+// newBaseDialers returns the dialers described by the transport config, or
+// plain TCP and UDP dialers when config is empty. Stream-only transports
+// such as split and tlsfrag leave UDP on a plain socket.
+func newBaseDialers(config string) (transport.StreamDialer, transport.PacketDialer, error) {
+	ctx := context.Background()
+	sd, err := transportProviders.NewStreamDialer(ctx, config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid transport: %w", err)
+	}
+	pd, err := transportProviders.NewPacketDialer(ctx, config)
+	if err != nil {
+		pd = newUDPDialer()
+	}
+	return sd, pd, nil
+}
+
+// newTransportDialers builds the dialers of transport-only mode, which
+// reach destinations through the transport alone, without a Shadowsocks
+// server. UDP goes direct unless the transport can relay it.
+func newTransportDialers(cfg SSConfig) (*ssDialers, error) {
+	ctx := context.Background()
+	sd, err := transportProviders.NewStreamDialer(ctx, cfg.Transport)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transport: %w", err)
+	}
+	pl, err := transportProviders.NewPacketListener(ctx, cfg.Transport)
+	if err != nil {
+		pl = newUDPListener()
+	}
+	name, err := configurl.SanitizeConfig(cfg.Transport)
+	if err != nil {
+		name = "transport"
+	}
+	return &ssDialers{server: name, stream: sd, packet: pl}, nil
+}
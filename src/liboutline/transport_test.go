@@ -0,0 +1,26 @@
+package liboutline
+
+import (
+	"reflect"
+	"testing"
+
+	"segment/global"
+)
+
+func TestBaseDialersAreProtected(t *testing.T) {
+	tests := []struct {
+		name    string
+		control any
+	}{
+		{"tcp dialer", newTCPDialer().Dialer.Control},
+		{"udp dialer", newUDPDialer().Dialer.Control},
+		{"udp listener", newUDPListener().ListenConfig.Control},
+	}
+
+	protect := reflect.ValueOf(global.ProtectSocket).Pointer()
+	for _, tt := range tests {
+		if v := reflect.ValueOf(tt.control); v.IsNil() || v.Pointer() != protect {
+			t.Errorf("%s does not run global.ProtectSocket", tt.name)
+		}
+	}
+}
@@ -0,0 +1,309 @@
+package libtun
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"segment/global"
+
+	M "github.com/xjasonlyu/tun2socks/v2/metadata"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// geoIPFile is the Xray geoip asset country lists are read from.
+const geoIPFile = "geoip.dat"
+
+// privateCIDRs are the ranges Private bypasses, as in generated Xray configs.
+var privateCIDRs = []string{
+	"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8",
+	"169.254.0.0/16", "172.16.0.0/12", "192.168.0.0/16",
+	"::1/128", "fc00::/7", "fe80::/10",
+}
+
+// BypassOptions lists the destinations dialed directly instead of through
+// the proxy.
+type BypassOptions struct {
+	Private  bool     // Private, loopback and link-local ranges
+	CIDRs    []string // Extra ranges
+	Domains  []string // Domains and their subdomains; needs fake IP DNS
+	GeoIP    []string // Country codes of geoip.dat, like "ir"
+	AssetDir string   // Directory holding geoip.dat
+}
+
+func (o BypassOptions) validate(dns DNSOptions) error {
+	for _, cidr := range o.CIDRs {
+		if _, err := netip.ParsePrefix(strings.TrimSpace(cidr)); err != nil {
+			return fmt.Errorf("invalid bypass CIDR %q", cidr)
+		}
+	}
+	if len(o.Domains) > 0 && !dns.FakeIP {
+		return errors.New("domain bypass needs fake IP DNS to know the domains")
+	}
+	for _, domain := range o.Domains {
+		if normalizeDomain(domain) == "" {
+			return fmt.Errorf("invalid bypass domain %q", domain)
+		}
+	}
+	if len(o.GeoIP) > 0 {
+		if o.AssetDir == "" {
+			return errors.New("geoip bypass needs the asset directory")
+		}
+		if _, err := os.Stat(filepath.Join(o.AssetDir, geoIPFile)); err != nil {
+			return fmt.Errorf("geoip bypass: %w", err)
+		}
+	}
+	return nil
+}
+
+// bypass matches destinations against the rules.
+type bypass struct {
+	ranges  []ipRange // Sorted and disjoint
+	domains []string
+}
+
+type ipRange struct {
+	first, last netip.Addr
+}
+
+// newBypass builds the rules, reading the country lists from geoip.dat.
+// It returns nil when there is nothing to bypass.
+func newBypass(opts BypassOptions) (*bypass, error) {
+	var prefixes []netip.Prefix
+	cidrs := opts.CIDRs
+	if opts.Private {
+		cidrs = append(slices.Clone(cidrs), privateCIDRs...)
+	}
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(cidr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid bypass CIDR %q", cidr)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	if len(opts.GeoIP) > 0 {
+		geo, err := loadGeoIP(filepath.Join(opts.AssetDir, geoIPFile), opts.GeoIP)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, geo...)
+	}
+
+	b := &bypass{ranges: mergeRanges(prefixes)}
+	for _, domain := range opts.Domains {
+		b.domains = append(b.domains, normalizeDomain(domain))
+	}
+	if len(b.ranges) == 0 && len(b.domains) == 0 {
+		return nil, nil
+	}
+	return b, nil
+}
+
+// match reports whether a session to ip, or to domain when it was dialed
+// through a fake IP, goes direct.
+func (b *bypass) match(ip netip.Addr, domain string) bool {
+	if b == nil {
+		return false
+	}
+	if domain != "" {
+		for _, d := range b.domains {
+			if domain == d || strings.HasSuffix(domain, "."+d) {
+				return true
+			}
+		}
+		return false
+	}
+	ip = ip.Unmap()
+	i, found := slices.BinarySearchFunc(b.ranges, ip, func(r ipRange, ip netip.Addr) int {
+		return r.first.Compare(ip)
+	})
+	if found {
+		return true
+	}
+	return i > 0 && b.ranges[i-1].last.Compare(ip) >= 0
+}
+
+// mergeRanges turns prefixes into sorted ranges, joining overlapping ones
+// so a lookup is a binary search.
+func mergeRanges(prefixes []netip.Prefix) []ipRange {
+	ranges := make([]ipRange, 0, len(prefixes))
+	for _, p := range prefixes {
+		ranges = append(ranges, ipRange{first: p.Addr(), last: lastAddr(p)})
+	}
+	slices.SortFunc(ranges, func(a, b ipRange) int {
+		return a.first.Compare(b.first)
+	})
+
+	merged := ranges[:0]
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && merged[n-1].last.BitLen() == r.first.BitLen() &&
+			(merged[n-1].last.Compare(r.first) >= 0 || merged[n-1].last.Next() == r.first) {
+			if r.last.Compare(merged[n-1].last) > 0 {
+				merged[n-1].last = r.last
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// lastAddr returns the highest address of a masked prefix.
+func lastAddr(p netip.Prefix) netip.Addr {
+	b := p.Addr().AsSlice()
+	for i := p.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 0x80 >> (i % 8)
+	}
+	addr, _ := netip.AddrFromSlice(b)
+	return addr
+}
+
+func normalizeDomain(domain string) string {
+	return strings.Trim(strings.ToLower(strings.TrimSpace(domain)), ".")
+}
+
+// loadGeoIP reads the ranges of the given countries from an Xray geoip.dat,
+// a GeoIPList message. Only the matching entries are decoded.
+func loadGeoIP(path string, codes []string) ([]netip.Prefix, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read geoip: %w", err)
+	}
+
+	wanted := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		wanted[strings.ToUpper(strings.TrimSpace(code))] = true
+	}
+
+	var prefixes []netip.Prefix
+	err = walkMessage(data, func(num protowire.Number, entry []byte) error {
+		if num != 1 {
+			return nil
+		}
+		var code string
+		var cidrs [][]byte
+		var reverse bool
+		err := walkMessage(entry, func(num protowire.Number, v []byte) error {
+			switch num {
+			case 1:
+				code = strings.ToUpper(string(v))
+			case 2:
+				cidrs = append(cidrs, v)
+			case 3:
+				reverse = len(v) > 0 && v[0] != 0
+			}
+			return nil
+		})
+		if err != nil || !wanted[code] {
+			return err
+		}
+		if reverse {
+			return fmt.Errorf("geoip %s is a reverse match list, which is not supported", code)
+		}
+		delete(wanted, code)
+		for _, cidr := range cidrs {
+			prefix, err := decodeCIDR(cidr)
+			if err != nil {
+				return fmt.Errorf("geoip %s: %w", code, err)
+			}
+			prefixes = append(prefixes, prefix)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read geoip: %w", err)
+	}
+	for code := range wanted {
+		return nil, fmt.Errorf("geoip %s not found in %s", code, geoIPFile)
+	}
+	return prefixes, nil
+}
+
+// decodeCIDR decodes a CIDR message: ip bytes and a prefix length.
+func decodeCIDR(b []byte) (netip.Prefix, error) {
+	var ip []byte
+	var bits uint64
+	err := walkMessage(b, func(num protowire.Number, v []byte) error {
+		switch num {
+		case 1:
+			ip = v
+		case 2:
+			var n int
+			if bits, n = protowire.ConsumeVarint(v); n < 0 {
+				return protowire.ParseError(n)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok || int(bits) > addr.BitLen() {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR %v/%d", net.IP(ip), bits)
+	}
+	return netip.PrefixFrom(addr, int(bits)).Masked(), nil
+}
+
+// walkMessage calls fn with each field of a protobuf message. Bytes fields
+// are passed as their contents, varints as their encoding.
+func walkMessage(b []byte, fn func(protowire.Number, []byte) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		var v []byte
+		switch typ {
+		case protowire.BytesType:
+			v, n = protowire.ConsumeBytes(b)
+		case protowire.VarintType:
+			_, n = protowire.ConsumeVarint(b)
+			if n >= 0 {
+				v = b[:n]
+			}
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		if v == nil {
+			continue
+		}
+		if err := fn(num, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// direct dials bypassed destinations without the proxy, on sockets
+// protected from the VPN.
+type direct struct {
+	dialer   net.Dialer
+	listener net.ListenConfig
+}
+
+func newDirect() *direct {
+	return &direct{
+		dialer:   net.Dialer{Control: global.ProtectSocket},
+		listener: net.ListenConfig{Control: global.ProtectSocket},
+	}
+}
+
+func (d *direct) DialContext(ctx context.Context, m *M.Metadata) (net.Conn, error) {
+	return d.dialer.DialContext(ctx, "tcp", m.DestinationAddress())
+}
+
+func (d *direct) DialUDP(*M.Metadata) (net.PacketConn, error) {
+	return d.listener.ListenPacket(context.Background(), "udp", "")
+}
@@ -0,0 +1,26 @@
+package libtun
+
+import (
+	"reflect"
+	"testing"
+
+	"segment/global"
+)
+
+func TestDirectIsProtected(t *testing.T) {
+	d := newDirect()
+	tests := []struct {
+		name    string
+		control any
+	}{
+		{"tcp dialer", d.dialer.Control},
+		{"udp listener", d.listener.Control},
+	}
+
+	protect := reflect.ValueOf(global.ProtectSocket).Pointer()
+	for _, tt := range tests {
+		if v := reflect.ValueOf(tt.control); v.IsNil() || v.Pointer() != protect {
+			t.Errorf("%s does not run global.ProtectSocket", tt.name)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package libtun
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"segment/global"
+
+	"github.com/Jigsaw-Code/outline-sdk/transport"
+	"github.com/Jigsaw-Code/outline-sdk/transport/socks5"
+	M "github.com/xjasonlyu/tun2socks/v2/metadata"
+	"github.com/xjasonlyu/tun2socks/v2/proxy"
+)
+
+// dialer routes the sessions tun2socks accepts from the TUN device: DNS is
+// answered locally, bypassed destinations are dialed directly, fake IPs are
+// dialed by domain and the rest goes to the proxy.
+type dialer struct {
+	proxy  proxy.Dialer   // Dials the local proxy by IP
+	socks  *socks5.Client // Dials the local proxy by domain
+	dns    *dnsServer     // nil without DNS handling
+	bypass *bypass        // nil without bypass rules
+	direct *direct
+}
+
+func newDialer(proxyAddress string, auth *global.ProxyAuth, opts Options) (*dialer, error) {
+	client, err := socks5.NewClient(&transport.TCPEndpoint{Address: proxyAddress})
+	if err != nil {
+		return nil, err
+	}
+	if auth != nil {
+		if err := client.SetCredentials([]byte(auth.Username), []byte(auth.Password)); err != nil {
+			return nil, err
+		}
+	}
+
+	d := &dialer{socks: client, direct: newDirect()}
+	if opts.DNS.enabled() {
+		if d.dns, err = newDNSServer(opts.DNS, client); err != nil {
+			return nil, err
+		}
+	}
+	if d.bypass, err = newBypass(opts.Bypass); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *dialer) DialContext(ctx context.Context, m *M.Metadata) (net.Conn, error) {
+	if d.dns != nil && m.DstPort == 53 {
+		conn, server := net.Pipe()
+		go d.dns.serveTCP(server)
+		return conn, nil
+	}
+	domain, err := d.dns.domain(m.DstIP)
+	if err != nil {
+		return nil, err
+	}
+	if d.bypass.match(m.DstIP, domain) {
+		if domain != "" {
+			if m, err = d.resolve(ctx, m, domain); err != nil {
+				return nil, err
+			}
+		}
+		return d.direct.DialContext(ctx, m)
+	}
+	if domain != "" {
+		return d.socks.DialStream(ctx, net.JoinHostPort(domain, strconv.Itoa(int(m.DstPort))))
+	}
+	return d.proxy.DialContext(ctx, m)
+}
+
+func (d *dialer) DialUDP(m *M.Metadata) (net.PacketConn, error) {
+	if d.dns != nil && m.DstPort == 53 {
+		return newDNSConn(d.dns, m.DestinationAddrPort()), nil
+	}
+	domain, err := d.dns.domain(m.DstIP)
+	if err != nil {
+		return nil, err
+	}
+	fake := net.UDPAddrFromAddrPort(m.DestinationAddrPort())
+
+	if d.bypass.match(m.DstIP, domain) {
+		pc, err := d.direct.DialUDP(m)
+		if err != nil || domain == "" {
+			return pc, err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), dnsTimeout)
+		defer cancel()
+		resolved, err := d.resolve(ctx, m, domain)
+		if err != nil {
+			pc.Close()
+			return nil, err
+		}
+		target := net.UDPAddrFromAddrPort(resolved.DestinationAddrPort())
+		return &rewritePacketConn{PacketConn: pc, target: target, fake: fake}, nil
+	}
+
+	pc, err := d.proxy.DialUDP(m)
+	if err != nil || domain == "" {
+		return pc, err
+	}
+	target := domainAddr(net.JoinHostPort(domain, strconv.Itoa(int(m.DstPort))))
+	return &rewritePacketConn{PacketConn: pc, target: target, fake: fake}, nil
+}
+
+// resolve returns a copy of m aimed at an address of domain, looked up
+// through the DNS upstreams so the query does not leak.
+func (d *dialer) resolve(ctx context.Context, m *M.Metadata, domain string) (*M.Metadata, error) {
+	ip, err := d.dns.lookup(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+	resolved := *m
+	resolved.DstIP = ip
+	return &resolved, nil
+}
+
+// domainAddr is a host:port address the proxy resolves.
+type domainAddr string
+
+func (a domainAddr) Network() string { return "udp" }
+func (a domainAddr) String() string  { return string(a) }
+
+// rewritePacketConn sends a fake IP session's datagrams to its real target
+// and reports the replies as coming from the fake IP.
+type rewritePacketConn struct {
+	net.PacketConn
+	target net.Addr
+	fake   *net.UDPAddr
+}
+
+func (c *rewritePacketConn) WriteTo(b []byte, _ net.Addr) (int, error) {
+	return c.PacketConn.WriteTo(b, c.target)
+}
+
+func (c *rewritePacketConn) ReadFrom(b []byte) (int, net.Addr, error) {
+	n, _, err := c.PacketConn.ReadFrom(b)
+	if err != nil {
+		return n, nil, err
+	}
+	return n, c.fake, nil
+}
@@ -0,0 +1,404 @@
+package libtun
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Jigsaw-Code/outline-sdk/dns"
+	"github.com/Jigsaw-Code/outline-sdk/transport"
+	t2slog "github.com/xjasonlyu/tun2socks/v2/log"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	// defaultFakeIPRange is the benchmarking range, which no real host uses.
+	defaultFakeIPRange = "198.18.0.0/15"
+	// fakeIPTTL is the TTL of fake answers, in seconds.
+	fakeIPTTL = 60
+	// dnsTimeout bounds resolving one query through the upstreams.
+	dnsTimeout = 10 * time.Second
+)
+
+// DNSOptions configures DNS handling on the TUN device. With upstreams,
+// queries to port 53 are answered locally over both UDP and TCP; without,
+// they go through the proxy like any other traffic.
+type DNSOptions struct {
+	Upstreams   []string // https:// (DoH) or tls:// (DoT) URLs, tried in order
+	FakeIP      bool     // Answer A queries with fake IPs the core resolves by name
+	FakeIPRange string   // IPv4 CIDR of the fake IPs, 198.18.0.0/15 when empty
+}
+
+func (o DNSOptions) enabled() bool {
+	return len(o.Upstreams) > 0
+}
+
+func (o DNSOptions) validate() error {
+	if !o.enabled() {
+		if o.FakeIP {
+			return errors.New("fake IP needs DNS upstreams")
+		}
+		return nil
+	}
+	for _, raw := range o.Upstreams {
+		if _, err := newUpstream(raw, nil); err != nil {
+			return err
+		}
+	}
+	if o.FakeIP {
+		if _, err := o.fakeIPRange(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o DNSOptions) fakeIPRange() (netip.Prefix, error) {
+	cidr := o.FakeIPRange
+	if cidr == "" {
+		cidr = defaultFakeIPRange
+	}
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil || !prefix.Addr().Is4() || prefix.Bits() > 24 {
+		return netip.Prefix{}, fmt.Errorf("invalid fake IP range %q: must be an IPv4 CIDR of /24 or larger", cidr)
+	}
+	return prefix.Masked(), nil
+}
+
+// newUpstream builds a resolver for a DoH or DoT URL, connecting through sd.
+func newUpstream(raw string, sd transport.StreamDialer) (dns.Resolver, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DNS upstream %q: %w", raw, err)
+	}
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("invalid DNS upstream %q: missing host", raw)
+	}
+	switch u.Scheme {
+	case "https":
+		return dns.NewHTTPSResolver(sd, u.Host, u.String()), nil
+	case "tls":
+		return dns.NewTLSResolver(sd, u.Host, u.Hostname()), nil
+	}
+	return nil, fmt.Errorf("invalid DNS upstream %q: scheme must be https or tls", raw)
+}
+
+// dnsServer answers the DNS queries sent to the TUN device.
+type dnsServer struct {
+	upstreams []dns.Resolver
+	fake      *fakeIPPool // nil unless fake IPs are enabled
+}
+
+// newDNSServer returns a server resolving through sd, which dials via the core.
+func newDNSServer(opts DNSOptions, sd transport.StreamDialer) (*dnsServer, error) {
+	s := &dnsServer{}
+	for _, raw := range opts.Upstreams {
+		r, err := newUpstream(raw, sd)
+		if err != nil {
+			return nil, err
+		}
+		s.upstreams = append(s.upstreams, r)
+	}
+	if opts.FakeIP {
+		prefix, err := opts.fakeIPRange()
+		if err != nil {
+			return nil, err
+		}
+		s.fake = sharedFakeIPPool(prefix)
+	}
+	return s, nil
+}
+
+// fakePool outlives the tunnel, so fake answers the OS cached before a
+// restart still map to their domains afterwards.
+var (
+	fakePoolMu sync.Mutex
+	fakePool   *fakeIPPool
+)
+
+// sharedFakeIPPool returns the process's pool, replaced only when the
+// range changes.
+func sharedFakeIPPool(prefix netip.Prefix) *fakeIPPool {
+	fakePoolMu.Lock()
+	defer fakePoolMu.Unlock()
+	if fakePool == nil || fakePool.prefix != prefix {
+		fakePool = newFakeIPPool(prefix)
+	}
+	return fakePool
+}
+
+// exchange answers a query message. Upstream failures are answered with
+// SERVFAIL; only unparsable queries return an error.
+func (s *dnsServer) exchange(ctx context.Context, query []byte) ([]byte, error) {
+	var p dnsmessage.Parser
+	hdr, err := p.Start(query)
+	if err != nil {
+		return nil, fmt.Errorf("parse query: %w", err)
+	}
+	q, err := p.Question()
+	if err != nil {
+		return nil, fmt.Errorf("parse question: %w", err)
+	}
+
+	resp := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:                 hdr.ID,
+			Response:           true,
+			OpCode:             hdr.OpCode,
+			RecursionDesired:   hdr.RecursionDesired,
+			RecursionAvailable: true,
+		},
+		Questions: []dnsmessage.Question{q},
+	}
+
+	switch {
+	case s.fake != nil && q.Class == dnsmessage.ClassINET && q.Type == dnsmessage.TypeA:
+		ip := s.fake.allocate(domainName(q.Name))
+		resp.Answers = []dnsmessage.Resource{{
+			Header: dnsmessage.ResourceHeader{Name: q.Name, Type: dnsmessage.TypeA, Class: dnsmessage.ClassINET, TTL: fakeIPTTL},
+			Body:   &dnsmessage.AResource{A: ip.As4()},
+		}}
+	case s.fake != nil && q.Class == dnsmessage.ClassINET && q.Type == dnsmessage.TypeAAAA:
+		// Fake IPs are IPv4 only; an empty answer makes clients use them.
+	default:
+		answer, err := s.resolve(ctx, q)
+		if err != nil {
+			t2slog.Warnf("[DNS] resolve %s %s: %v", q.Name, q.Type, err)
+			resp.RCode = dnsmessage.RCodeServerFailure
+			break
+		}
+		resp.RCode = answer.RCode
+		resp.Answers = answer.Answers
+		resp.Authorities = answer.Authorities
+	}
+	return resp.Pack()
+}
+
+// resolve asks the upstreams in order until one answers.
+func (s *dnsServer) resolve(ctx context.Context, q dnsmessage.Question) (*dnsmessage.Message, error) {
+	var errs []error
+	for _, upstream := range s.upstreams {
+		answer, err := upstream.Query(ctx, q)
+		if err == nil {
+			return answer, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, errors.Join(errs...)
+}
+
+// lookup resolves a domain to one of its IPv4 addresses.
+func (s *dnsServer) lookup(ctx context.Context, domain string) (netip.Addr, error) {
+	name, err := dnsmessage.NewName(domain + ".")
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	answer, err := s.resolve(ctx, dnsmessage.Question{Name: name, Type: dnsmessage.TypeA, Class: dnsmessage.ClassINET})
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	for _, rr := range answer.Answers {
+		if a, ok := rr.Body.(*dnsmessage.AResource); ok {
+			return netip.AddrFrom4(a.A), nil
+		}
+	}
+	return netip.Addr{}, fmt.Errorf("no address found for %s", domain)
+}
+
+// domain returns the domain behind a fake IP. It returns "" for real IPs
+// and an error for fake IPs that are no longer mapped.
+func (s *dnsServer) domain(ip netip.Addr) (string, error) {
+	if s == nil || s.fake == nil || !s.fake.prefix.Contains(ip) {
+		return "", nil
+	}
+	name, ok := s.fake.lookup(ip)
+	if !ok {
+		return "", fmt.Errorf("fake IP %s is not mapped to a domain", ip)
+	}
+	return name, nil
+}
+
+// serveTCP answers the length-prefixed queries of a DNS over TCP session
+// until the client closes it.
+func (s *dnsServer) serveTCP(conn net.Conn) {
+	defer conn.Close()
+	for {
+		var size [2]byte
+		if _, err := io.ReadFull(conn, size[:]); err != nil {
+			return
+		}
+		query := make([]byte, binary.BigEndian.Uint16(size[:]))
+		if _, err := io.ReadFull(conn, query); err != nil {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), dnsTimeout)
+		reply, err := s.exchange(ctx, query)
+		cancel()
+		if err != nil {
+			t2slog.Debugf("[DNS] drop TCP query: %v", err)
+			return
+		}
+		msg := binary.BigEndian.AppendUint16(make([]byte, 0, 2+len(reply)), uint16(len(reply)))
+		if _, err := conn.Write(append(msg, reply...)); err != nil {
+			return
+		}
+	}
+}
+
+func domainName(name dnsmessage.Name) string {
+	return strings.TrimSuffix(strings.ToLower(name.String()), ".")
+}
+
+// fakeIPPool hands out the addresses of a range to domains in turn. Once
+// the range is used up the oldest mappings are reused.
+type fakeIPPool struct {
+	mu     sync.Mutex
+	prefix netip.Prefix
+	next   netip.Addr
+	byIP   map[netip.Addr]string
+	byName map[string]netip.Addr
+}
+
+func newFakeIPPool(prefix netip.Prefix) *fakeIPPool {
+	return &fakeIPPool{
+		prefix: prefix,
+		next:   prefix.Addr().Next(),
+		byIP:   make(map[netip.Addr]string),
+		byName: make(map[string]netip.Addr),
+	}
+}
+
+func (p *fakeIPPool) allocate(name string) netip.Addr {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if ip, ok := p.byName[name]; ok {
+		return ip
+	}
+	ip := p.next
+	if p.next = ip.Next(); !p.prefix.Contains(p.next) {
+		// Skip the network address on wrap-around.
+		p.next = p.prefix.Addr().Next()
+	}
+	if old, ok := p.byIP[ip]; ok {
+		delete(p.byName, old)
+	}
+	p.byIP[ip] = name
+	p.byName[name] = ip
+	return ip
+}
+
+func (p *fakeIPPool) lookup(ip netip.Addr) (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	name, ok := p.byIP[ip]
+	return name, ok
+}
+
+// dnsConn stands in for the proxy on port 53 sessions: queries written to
+// it are answered by the DNS server and the replies read back.
+type dnsConn struct {
+	server       *dnsServer
+	remote       *net.UDPAddr // The address queried, replies come from it
+	replies      chan []byte
+	done         chan struct{}
+	closeOnce    sync.Once
+	readDeadline atomic.Pointer[time.Time]
+}
+
+func newDNSConn(server *dnsServer, remote netip.AddrPort) *dnsConn {
+	return &dnsConn{
+		server:  server,
+		remote:  net.UDPAddrFromAddrPort(remote),
+		replies: make(chan []byte, 16),
+		done:    make(chan struct{}),
+	}
+}
+
+func (c *dnsConn) WriteTo(b []byte, _ net.Addr) (int, error) {
+	select {
+	case <-c.done:
+		return 0, net.ErrClosed
+	default:
+	}
+
+	query := append([]byte(nil), b...)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), dnsTimeout)
+		defer cancel()
+		reply, err := c.server.exchange(ctx, query)
+		if err != nil {
+			t2slog.Debugf("[DNS] drop query to %s: %v", c.remote, err)
+			return
+		}
+		select {
+		case c.replies <- reply:
+		case <-c.done:
+		}
+	}()
+	return len(b), nil
+}
+
+func (c *dnsConn) ReadFrom(b []byte) (int, net.Addr, error) {
+	for {
+		deadline := c.readDeadline.Load()
+		n, retry, err := c.read(b, deadline)
+		if !retry {
+			return n, c.remote, err
+		}
+		// The deadline was pushed back while waiting.
+		if d := c.readDeadline.Load(); d == deadline || (!d.IsZero() && !time.Now().Before(*d)) {
+			return 0, nil, os.ErrDeadlineExceeded
+		}
+	}
+}
+
+// read waits for a reply until deadline, reporting retry when it passes.
+func (c *dnsConn) read(b []byte, deadline *time.Time) (n int, retry bool, err error) {
+	var expired <-chan time.Time
+	if deadline != nil && !deadline.IsZero() {
+		timer := time.NewTimer(time.Until(*deadline))
+		defer timer.Stop()
+		expired = timer.C
+	}
+	select {
+	case reply := <-c.replies:
+		return copy(b, reply), false, nil
+	case <-c.done:
+		return 0, false, net.ErrClosed
+	case <-expired:
+		return 0, true, nil
+	}
+}
+
+func (c *dnsConn) Close() error {
+	c.closeOnce.Do(func() { close(c.done) })
+	return nil
+}
+
+func (c *dnsConn) LocalAddr() net.Addr {
+	return c.remote
+}
+
+func (c *dnsConn) SetDeadline(t time.Time) error {
+	return c.SetReadDeadline(t)
+}
+
+func (c *dnsConn) SetReadDeadline(t time.Time) error {
+	c.readDeadline.Store(&t)
+	return nil
+}
+
+func (c *dnsConn) SetWriteDeadline(time.Time) error {
+	return nil
+}
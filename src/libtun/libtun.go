@@ -2,19 +2,35 @@ package libtun
 
 import (
 	"fmt"
+	"runtime"
+	"strconv"
 	"sync"
+	"time"
 
-	"github.com/xjasonlyu/tun2socks/v2/engine"
+	"segment/corestate"
+	"segment/global"
+
+	"github.com/xjasonlyu/tun2socks/v2/core"
+	"github.com/xjasonlyu/tun2socks/v2/core/device"
+	"github.com/xjasonlyu/tun2socks/v2/core/device/fdbased"
+	"github.com/xjasonlyu/tun2socks/v2/proxy"
+	"github.com/xjasonlyu/tun2socks/v2/tunnel"
+	"gvisor.dev/gvisor/pkg/tcpip/stack"
 )
 
 var (
-	key     = new(engine.Key)
-	started bool // Simple boolean flag for checking the started state
-	mu      sync.Mutex
+	started   bool // Simple boolean flag for checking the started state
+	startedAt time.Time
+	proxyAddr string
+	proxyAuth *global.ProxyAuth
+	dev       device.Device
+	netstack  *stack.Stack // The stack reading the TUN device, owned here so its counters can be read
+	mu        sync.Mutex
 )
 
-// Start initializes tun2socks with the given TUN file descriptor and proxy address.
-func Start(tunFD int, proxyAddress string) error {
+// Start initializes tun2socks with the given TUN file descriptor and proxy
+// address. auth holds the proxy's credentials, nil if it needs none.
+func Start(tunFD int, proxyAddress string, auth *global.ProxyAuth, opts Options) (err error) {
 	mu.Lock()
 	defer mu.Unlock()
 
@@ -22,24 +38,90 @@ func Start(tunFD int, proxyAddress string) error {
 	if started {
 		return fmt.Errorf("tun2socks has already been started")
 	}
-	// Mark as started
+
+	corestate.Publish(corestate.Tun, corestate.Starting, nil)
+	defer func() {
+		if err != nil {
+			stopRestAPI()
+			corestate.Publish(corestate.Tun, corestate.Failed, err)
+		}
+	}()
+
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	d, err := newDialer(proxyAddress, auth, opts)
+	if err != nil {
+		return err
+	}
+	var user, pass string
+	if auth != nil {
+		user, pass = auth.Username, auth.Password
+	}
+	if d.proxy, err = proxy.NewSocks5(proxyAddress, user, pass); err != nil {
+		return err
+	}
+	if err := opts.configure(); err != nil {
+		return err
+	}
+
+	tunDev, err := openDevice(tunFD, opts.mtu())
+	if err != nil {
+		return err
+	}
+	tunnel.T().SetDialer(d)
+	s, err := core.CreateStack(&core.Config{
+		LinkEndpoint:     tunDev,
+		TransportHandler: tunnel.T(),
+		MulticastGroups:  opts.multicastGroups(),
+		Options:          opts.stackOptions(),
+	})
+	if err != nil {
+		tunDev.Close()
+		return fmt.Errorf("create stack: %w", err)
+	}
+
 	started = true
-	key.Device = fmt.Sprintf("fd://%d", tunFD)
-	key.Proxy = fmt.Sprintf("socks5://%s", proxyAddress)
-	key.MTU = 1500
-	key.LogLevel = "info"
-	engine.Insert(key)
-	engine.Start()
+	startedAt = time.Now()
+	proxyAddr, proxyAuth = proxyAddress, auth
+	dev, netstack = tunDev, s
+
+	corestate.Publish(corestate.Tun, corestate.Running, nil)
 	return nil
 }
 
-// Stop stops the tun2socks engine, resets state, and ensures the engine is ready for future starts.
+// Stop stops the tun2socks stack, resets state, and ensures it is ready for future starts.
 func Stop() {
 	mu.Lock()
 	defer mu.Unlock()
-	engine.Stop()
-	started = false       // Reset the started flag
-	key = new(engine.Key) // Reset key for the next Start call
+	corestate.Publish(corestate.Tun, corestate.Stopping, nil)
+	if dev != nil {
+		dev.Close()
+	}
+	if netstack != nil {
+		netstack.Close()
+		netstack.Wait()
+	}
+	stopRestAPI()
+	started = false // Reset the started flag
+	proxyAddr, proxyAuth = "", nil
+	dev, netstack = nil, nil
+	corestate.Publish(corestate.Tun, corestate.Stopped, nil)
+}
+
+// ProxyAddress returns the SOCKS address tun2socks forwards to, or "" when stopped.
+func ProxyAddress() string {
+	mu.Lock()
+	defer mu.Unlock()
+	return proxyAddr
+}
+
+// ProxyAuth returns the credentials tun2socks logs in with, or nil when
+// stopped or the proxy needs none.
+func ProxyAuth() *global.ProxyAuth {
+	mu.Lock()
+	defer mu.Unlock()
+	return proxyAuth
 }
 
 // IsStarted checks if tun2socks has been started.
@@ -48,3 +130,13 @@ func IsStarted() bool {
 	defer mu.Unlock()
 	return started
 }
+
+// openDevice wraps the TUN file descriptor. iOS prefixes packets with a
+// 4 byte protocol family.
+func openDevice(tunFD int, mtu uint32) (device.Device, error) {
+	offset := 0
+	if runtime.GOOS == "ios" {
+		offset = 4
+	}
+	return fdbased.Open(strconv.Itoa(tunFD), mtu, offset)
+}
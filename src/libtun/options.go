@@ -0,0 +1,154 @@
+package libtun
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/xjasonlyu/tun2socks/v2/core/option"
+	t2slog "github.com/xjasonlyu/tun2socks/v2/log"
+	"github.com/xjasonlyu/tun2socks/v2/restapi"
+	"github.com/xjasonlyu/tun2socks/v2/tunnel"
+	"gvisor.dev/gvisor/pkg/tcpip"
+)
+
+const (
+	defaultMTU      = 1500
+	defaultLogLevel = "info"
+
+	defaultUDPTimeout = 60 * time.Second // The tunnel's own default
+)
+
+// Options tunes the tun2socks stack. Zero values keep the defaults.
+type Options struct {
+	MTU                      int
+	UDPTimeout               time.Duration // At least a second
+	TCPSendBufferSize        int           // Bytes
+	TCPReceiveBufferSize     int           // Bytes
+	TCPModerateReceiveBuffer bool          // Let the stack auto-tune the receive buffer
+	LogLevel                 string        // debug, info, warning, error or silent
+	RestAPI                  string        // host:port of the tun2socks REST API, empty to disable
+	MulticastGroups          []string      // Multicast addresses to join
+	DNS                      DNSOptions    // DNS handling, off by default
+	Bypass                   BypassOptions // Destinations that skip the proxy
+}
+
+// Validate rejects options the stack would fail on, so they are checked
+// before anything is started.
+func (o Options) Validate() error {
+	if o.MTU != 0 && (o.MTU < 576 || o.MTU > 65535) {
+		return fmt.Errorf("invalid MTU %d: must be between 576 and 65535", o.MTU)
+	}
+	if o.UDPTimeout != 0 && o.UDPTimeout < time.Second {
+		return fmt.Errorf("invalid UDP timeout %v: must be at least a second", o.UDPTimeout)
+	}
+	if o.TCPSendBufferSize < 0 || o.TCPReceiveBufferSize < 0 {
+		return errors.New("invalid TCP buffer size: must not be negative")
+	}
+	if o.LogLevel != "" {
+		if _, err := t2slog.ParseLevel(o.LogLevel); err != nil {
+			return fmt.Errorf("invalid log level %q", o.LogLevel)
+		}
+	}
+	if o.RestAPI != "" {
+		if _, err := parseRestAPI(o.RestAPI); err != nil {
+			return err
+		}
+	}
+	for _, group := range o.MulticastGroups {
+		addr, err := netip.ParseAddr(strings.TrimSpace(group))
+		if err != nil || !addr.IsMulticast() {
+			return fmt.Errorf("invalid multicast group %q", group)
+		}
+	}
+	if err := o.DNS.validate(); err != nil {
+		return err
+	}
+	return o.Bypass.validate(o.DNS)
+}
+
+func (o Options) mtu() uint32 {
+	if o.MTU == 0 {
+		return defaultMTU
+	}
+	return uint32(o.MTU)
+}
+
+func (o Options) multicastGroups() []netip.Addr {
+	groups := make([]netip.Addr, 0, len(o.MulticastGroups))
+	for _, group := range o.MulticastGroups {
+		groups = append(groups, netip.MustParseAddr(strings.TrimSpace(group)))
+	}
+	return groups
+}
+
+func (o Options) stackOptions() []option.Option {
+	var opts []option.Option
+	if o.TCPModerateReceiveBuffer {
+		opts = append(opts, option.WithTCPModerateReceiveBuffer(true))
+	}
+	if o.TCPSendBufferSize > 0 {
+		opts = append(opts, option.WithTCPSendBufferSize(o.TCPSendBufferSize))
+	}
+	if o.TCPReceiveBufferSize > 0 {
+		opts = append(opts, option.WithTCPReceiveBufferSize(o.TCPReceiveBufferSize))
+	}
+	return opts
+}
+
+// configure applies the process-wide settings: the log level, the UDP
+// session timeout and the REST API.
+func (o Options) configure() error {
+	logLevel := defaultLogLevel
+	if o.LogLevel != "" {
+		logLevel = o.LogLevel
+	}
+	level, err := t2slog.ParseLevel(logLevel)
+	if err != nil {
+		return err
+	}
+	t2slog.SetLogger(t2slog.Must(t2slog.NewLeveled(level)))
+
+	// The tunnel is global, so a timeout left by an earlier Start is reset.
+	udpTimeout := defaultUDPTimeout
+	if o.UDPTimeout > 0 {
+		udpTimeout = o.UDPTimeout
+	}
+	tunnel.T().SetUDPTimeout(udpTimeout)
+
+	if o.RestAPI != "" {
+		u, err := parseRestAPI(o.RestAPI)
+		if err != nil {
+			return err
+		}
+		restapi.SetStatsFunc(func() tcpip.Stats {
+			mu.Lock()
+			defer mu.Unlock()
+			if netstack == nil {
+				return tcpip.Stats{}
+			}
+			return netstack.Stats()
+		})
+		if err := startRestAPI(u); err != nil {
+			return err
+		}
+		t2slog.Infof("[RESTAPI] serve at: %s", u.Host)
+	}
+	return nil
+}
+
+// parseRestAPI parses a [token@]host:port REST API address.
+func parseRestAPI(s string) (*url.URL, error) {
+	u, err := url.Parse("http://" + strings.TrimPrefix(s, "http://"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid REST API address: %w", err)
+	}
+	if _, err := net.ResolveTCPAddr("tcp", u.Host); err != nil {
+		return nil, fmt.Errorf("invalid REST API address: %w", err)
+	}
+	return u, nil
+}
@@ -0,0 +1,117 @@
+package libtun
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"sync"
+
+	t2slog "github.com/xjasonlyu/tun2socks/v2/log"
+	"github.com/xjasonlyu/tun2socks/v2/restapi"
+)
+
+// The tun2socks REST API can be neither stopped nor restarted with another
+// token, so it is started once per process on a loopback port behind a
+// random token. Each Start serves it on the requested address through a
+// proxy owned here, which Stop shuts down. All of it is guarded by mu.
+var (
+	restAPIOnce    sync.Once
+	restAPIBackend *url.URL // Where the tun2socks REST API listens
+	restAPIKey     string   // The token the backend was started with
+	restAPIErr     error
+	restAPIServer  *http.Server // The proxy of the running instance, nil when stopped
+)
+
+// startRestAPI serves the REST API on u.Host, requiring u's user as the
+// token when it is set.
+func startRestAPI(u *url.URL) error {
+	restAPIOnce.Do(func() {
+		restAPIBackend, restAPIKey, restAPIErr = startRestAPIBackend()
+	})
+	if restAPIErr != nil {
+		return restAPIErr
+	}
+
+	ln, err := net.Listen("tcp", u.Host)
+	if err != nil {
+		return fmt.Errorf("start REST API: %w", err)
+	}
+	proxy := httputil.NewSingleHostReverseProxy(restAPIBackend)
+	proxy.FlushInterval = -1 // /traffic streams
+	director := proxy.Director
+	proxy.Director = func(r *http.Request) {
+		director(r)
+		q := r.URL.Query()
+		q.Del("token")
+		r.URL.RawQuery = q.Encode()
+		r.Header.Set("Authorization", "Bearer "+restAPIKey)
+	}
+	server := &http.Server{Handler: authenticate(u.User.String(), proxy)}
+	go func() {
+		if err := server.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			t2slog.Errorf("[RESTAPI] failed to serve: %v", err)
+		}
+	}()
+	restAPIServer = server
+	return nil
+}
+
+// stopRestAPI closes the REST API proxy and its connections, if running.
+func stopRestAPI() {
+	if restAPIServer != nil {
+		restAPIServer.Close()
+		restAPIServer = nil
+	}
+}
+
+// startRestAPIBackend starts the tun2socks REST API on a free loopback port.
+func startRestAPIBackend() (*url.URL, string, error) {
+	key := make([]byte, 16)
+	if _, err := rand.Read(key); err != nil {
+		return nil, "", fmt.Errorf("start REST API: %w", err)
+	}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, "", fmt.Errorf("start REST API: %w", err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	token := hex.EncodeToString(key)
+	go func() {
+		if err := restapi.Start(addr, token); err != nil {
+			t2slog.Errorf("[RESTAPI] failed to start: %v", err)
+		}
+	}()
+	return &url.URL{Scheme: "http", Host: addr}, token, nil
+}
+
+// authenticate checks the token the way tun2socks does: a Bearer
+// Authorization header, or a token query parameter on WebSocket upgrades,
+// which browsers cannot add headers to. CORS preflights pass through.
+func authenticate(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token == "" || r.Method == http.MethodOptions {
+			next.ServeHTTP(w, r)
+			return
+		}
+		got := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") && r.URL.Query().Get("token") != "" {
+			got = r.URL.Query().Get("token")
+		}
+		if got != token {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(restapi.ErrUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,63 @@
+package libtun
+
+import (
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRestAPIRestart(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	get := func(token string) int {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodGet, "http://"+addr+"/version", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		// The backend starts in the background, so the first request may race it.
+		for i := 0; ; i++ {
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("GET /version: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusBadGateway || i == 50 {
+				return resp.StatusCode
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	for _, token := range []string{"first", "second"} {
+		u, err := parseRestAPI(token + "@" + addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mu.Lock()
+		err = startRestAPI(u)
+		mu.Unlock()
+		if err != nil {
+			t.Fatalf("start with token %q: %v", token, err)
+		}
+		if got := get(token); got != http.StatusOK {
+			t.Errorf("token %q: status = %d, want 200", token, got)
+		}
+		if got := get("wrong"); got != http.StatusUnauthorized {
+			t.Errorf("wrong token: status = %d, want 401", got)
+		}
+		mu.Lock()
+		stopRestAPI()
+		mu.Unlock()
+	}
+
+	if _, err := http.Get("http://" + addr + "/version"); err == nil {
+		t.Errorf("REST API still serving after stop")
+	}
+}
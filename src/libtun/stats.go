@@ -0,0 +1,51 @@
+package libtun
+
+import (
+	"errors"
+	"net"
+	"time"
+
+	"github.com/xjasonlyu/tun2socks/v2/tunnel/statistic"
+)
+
+// Snapshot describes the traffic on the TUN device. In is what the OS sent
+// into the tunnel, out what the tunnel wrote back to it.
+type Snapshot struct {
+	PacketsIn      uint64
+	PacketsOut     uint64
+	BytesIn        uint64
+	BytesOut       uint64
+	DroppedPackets uint64 // Dropped by the stack or for lack of buffer space
+	TCPSessions    int    // Sessions currently relayed
+	UDPSessions    int
+	Uptime         time.Duration
+}
+
+// Stats returns the counters of the running tunnel.
+func Stats() (Snapshot, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if !started {
+		return Snapshot{}, errors.New("tun2socks is not started")
+	}
+
+	s := netstack.Stats()
+	stats := Snapshot{
+		PacketsIn:      s.NICs.Rx.Packets.Value(),
+		PacketsOut:     s.NICs.Tx.Packets.Value(),
+		BytesIn:        s.NICs.Rx.Bytes.Value(),
+		BytesOut:       s.NICs.Tx.Bytes.Value(),
+		DroppedPackets: s.DroppedPackets.Value() + s.NICs.TxPacketsDroppedNoBufferSpace.Value(),
+		Uptime:         time.Since(startedAt),
+	}
+	for _, c := range statistic.DefaultManager.Snapshot().Connections {
+		// UDP trackers wrap a net.PacketConn, TCP trackers a net.Conn.
+		if _, ok := c.(net.PacketConn); ok {
+			stats.UDPSessions++
+		} else {
+			stats.TCPSessions++
+		}
+	}
+	return stats, nil
+}
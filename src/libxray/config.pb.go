@@ -0,0 +1,116 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: libxray/config.proto
+
+package libxray
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ConnTrackerConfig is registered as an Xray app config to install the
+// connection tracking dispatcher. It only lives inside the core config and
+// is never sent over the wire.
+type ConnTrackerConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnTrackerConfig) Reset() {
+	*x = ConnTrackerConfig{}
+	mi := &file_libxray_config_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnTrackerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnTrackerConfig) ProtoMessage() {}
+
+func (x *ConnTrackerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_libxray_config_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnTrackerConfig.ProtoReflect.Descriptor instead.
+func (*ConnTrackerConfig) Descriptor() ([]byte, []int) {
+	return file_libxray_config_proto_rawDescGZIP(), []int{0}
+}
+
+var File_libxray_config_proto protoreflect.FileDescriptor
+
+const file_libxray_config_proto_rawDesc = "" +
+	"\n" +
+	"\x14libxray/config.proto\x12\x0fsegment.libxray\"\x13\n" +
+	"\x11ConnTrackerConfigB\x11Z\x0fsegment/libxrayb\x06proto3"
+
+var (
+	file_libxray_config_proto_rawDescOnce sync.Once
+	file_libxray_config_proto_rawDescData []byte
+)
+
+func file_libxray_config_proto_rawDescGZIP() []byte {
+	file_libxray_config_proto_rawDescOnce.Do(func() {
+		file_libxray_config_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libxray_config_proto_rawDesc), len(file_libxray_config_proto_rawDesc)))
+	})
+	return file_libxray_config_proto_rawDescData
+}
+
+var file_libxray_config_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_libxray_config_proto_goTypes = []any{
+	(*ConnTrackerConfig)(nil), // 0: segment.libxray.ConnTrackerConfig
+}
+var file_libxray_config_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_libxray_config_proto_init() }
+func file_libxray_config_proto_init() {
+	if File_libxray_config_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libxray_config_proto_rawDesc), len(file_libxray_config_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_libxray_config_proto_goTypes,
+		DependencyIndexes: file_libxray_config_proto_depIdxs,
+		MessageInfos:      file_libxray_config_proto_msgTypes,
+	}.Build()
+	File_libxray_config_proto = out.File
+	file_libxray_config_proto_goTypes = nil
+	file_libxray_config_proto_depIdxs = nil
+}
@@ -0,0 +1,192 @@
+package libxray
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"segment/conntrack"
+
+	"github.com/GFW-knocker/Xray-core/app/dispatcher"
+	"github.com/GFW-knocker/Xray-core/common"
+	"github.com/GFW-knocker/Xray-core/common/buf"
+	xraynet "github.com/GFW-knocker/Xray-core/common/net"
+	"github.com/GFW-knocker/Xray-core/common/serial"
+	"github.com/GFW-knocker/Xray-core/common/session"
+	"github.com/GFW-knocker/Xray-core/core"
+	"github.com/GFW-knocker/Xray-core/features/routing"
+	"github.com/GFW-knocker/Xray-core/transport"
+)
+
+func init() {
+	common.Must(common.RegisterConfig((*ConnTrackerConfig)(nil), func(ctx context.Context, _ interface{}) (interface{}, error) {
+		inner, err := common.CreateObject(ctx, &dispatcher.Config{})
+		if err != nil {
+			return nil, err
+		}
+		return &trackingDispatcher{Dispatcher: inner.(routing.Dispatcher)}, nil
+	}))
+}
+
+// enableConnTracking swaps the default dispatcher app for trackingDispatcher.
+// Configs using features that require the concrete default dispatcher
+// (vless inbounds, reverse proxy) are left untouched, and the reason is
+// returned so it can be logged.
+func enableConnTracking(config *core.Config) string {
+	for _, in := range config.Inbound {
+		if in.ProxySettings != nil && in.ProxySettings.Type == "xray.proxy.vless.inbound.Config" {
+			return "vless inbounds need the default dispatcher"
+		}
+	}
+	for _, app := range config.App {
+		if app.Type == "xray.app.reverse.Config" {
+			return "the reverse proxy needs the default dispatcher"
+		}
+	}
+
+	dispatcherType := serial.GetMessageType(&dispatcher.Config{})
+	for i, app := range config.App {
+		if app.Type == dispatcherType {
+			config.App[i] = serial.ToTypedMessage(&ConnTrackerConfig{})
+			return ""
+		}
+	}
+	return "the config has no dispatcher"
+}
+
+// trackingDispatcher registers every dispatched link in the connection table.
+type trackingDispatcher struct {
+	routing.Dispatcher
+}
+
+// Dispatch implements routing.Dispatcher. The returned link is used by an
+// inbound, which writes the uplink and reads the downlink.
+func (d *trackingDispatcher) Dispatch(ctx context.Context, dest xraynet.Destination) (*transport.Link, error) {
+	link, err := d.Dispatcher.Dispatch(ctx, dest)
+	if err != nil {
+		return nil, err
+	}
+	t := newTrackedLink(ctx, dest, link)
+	return &transport.Link{
+		Reader: &trackedReader{Reader: link.Reader, link: t, count: t.entry.AddDownlink},
+		Writer: &trackedWriter{Writer: link.Writer, link: t, count: t.entry.AddUplink},
+	}, nil
+}
+
+// DispatchLink implements routing.Dispatcher. The given link is handed to an
+// outbound, which reads the uplink and writes the downlink.
+func (d *trackingDispatcher) DispatchLink(ctx context.Context, dest xraynet.Destination, link *transport.Link) error {
+	t := newTrackedLink(ctx, dest, link)
+	return d.Dispatcher.DispatchLink(ctx, dest, &transport.Link{
+		Reader: &trackedReader{Reader: link.Reader, link: t, count: t.entry.AddUplink},
+		Writer: &trackedWriter{Writer: link.Writer, link: t, count: t.entry.AddDownlink},
+	})
+}
+
+// trackedLink leaves the connection table once both directions are finished.
+type trackedLink struct {
+	entry   *conntrack.Entry
+	pending atomic.Int32
+}
+
+func newTrackedLink(ctx context.Context, dest xraynet.Destination, link *transport.Link) *trackedLink {
+	meta := conntrack.Metadata{
+		Core:        GetXrayService().CoreName(),
+		Network:     dest.Network.SystemString(),
+		Destination: dest.NetAddr(),
+	}
+	if inbound := session.InboundFromContext(ctx); inbound != nil {
+		meta.Inbound = inbound.Tag
+		if inbound.Source.IsValid() {
+			meta.Source = inbound.Source.NetAddr()
+		}
+	}
+	if outbounds := session.OutboundsFromContext(ctx); len(outbounds) > 0 {
+		ob := outbounds[len(outbounds)-1]
+		meta.OutboundFunc = func() string { return ob.Tag }
+	}
+
+	t := &trackedLink{}
+	t.pending.Store(2)
+	t.entry = conntrack.Add(meta, func() {
+		common.Interrupt(link.Reader)
+		common.Interrupt(link.Writer)
+	})
+	return t
+}
+
+func (t *trackedLink) finish() {
+	if t.pending.Add(-1) == 0 {
+		t.entry.Done()
+	}
+}
+
+type trackedReader struct {
+	buf.Reader
+	link     *trackedLink
+	count    func(int64)
+	finished atomic.Bool
+}
+
+func (r *trackedReader) ReadMultiBuffer() (buf.MultiBuffer, error) {
+	mb, err := r.Reader.ReadMultiBuffer()
+	r.count(int64(mb.Len()))
+	if err != nil {
+		r.finish()
+	}
+	return mb, err
+}
+
+// ReadMultiBufferTimeout keeps buf.TimeoutReader working through the wrapper.
+func (r *trackedReader) ReadMultiBufferTimeout(timeout time.Duration) (buf.MultiBuffer, error) {
+	tr, ok := r.Reader.(buf.TimeoutReader)
+	if !ok {
+		return r.ReadMultiBuffer()
+	}
+	mb, err := tr.ReadMultiBufferTimeout(timeout)
+	r.count(int64(mb.Len()))
+	if err != nil && err != buf.ErrReadTimeout {
+		r.finish()
+	}
+	return mb, err
+}
+
+func (r *trackedReader) Interrupt() {
+	common.Interrupt(r.Reader)
+	r.finish()
+}
+
+func (r *trackedReader) finish() {
+	if r.finished.CompareAndSwap(false, true) {
+		r.link.finish()
+	}
+}
+
+type trackedWriter struct {
+	buf.Writer
+	link     *trackedLink
+	count    func(int64)
+	finished atomic.Bool
+}
+
+func (w *trackedWriter) WriteMultiBuffer(mb buf.MultiBuffer) error {
+	w.count(int64(mb.Len()))
+	return w.Writer.WriteMultiBuffer(mb)
+}
+
+func (w *trackedWriter) Close() error {
+	err := common.Close(w.Writer)
+	w.finish()
+	return err
+}
+
+func (w *trackedWriter) Interrupt() {
+	common.Interrupt(w.Writer)
+	w.finish()
+}
+
+func (w *trackedWriter) finish() {
+	if w.finished.CompareAndSwap(false, true) {
+		w.link.finish()
+	}
+}
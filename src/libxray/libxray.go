@@ -5,16 +5,27 @@ import (
 	"errors"
 	"fmt"
 	"net"
-	"net/http"
+	"segment/conntrack"
+	"segment/corestate"
 	"segment/global"
+	"segment/latency"
 	log "segment/libxray/slog"
 	"segment/proxycoreproto"
+	"segment/traffic"
+	"strings"
 	"sync"
 	"time"
 
 	xraynet "github.com/GFW-knocker/Xray-core/common/net"
 	"github.com/GFW-knocker/Xray-core/core"
+	"github.com/GFW-knocker/Xray-core/features/inbound"
+	"github.com/GFW-knocker/Xray-core/features/outbound"
+	"github.com/GFW-knocker/Xray-core/features/stats"
 	_ "github.com/GFW-knocker/Xray-core/main/distro/all"
+	"github.com/GFW-knocker/Xray-core/proxy"
+	"github.com/GFW-knocker/Xray-core/proxy/blackhole"
+	"github.com/GFW-knocker/Xray-core/proxy/dns"
+	"github.com/GFW-knocker/Xray-core/proxy/freedom"
 )
 
 // XrayService encapsulates the core instance and server lifecycle management.
@@ -23,8 +34,18 @@ type XrayService struct {
 	mutex     sync.Mutex     // Ensures thread-safe access to the instance
 	isRunning bool           // Tracks if the server is running
 	readyChan chan struct{}  // Channel to signal when service is fully ready
+	sampler   traffic.Sampler
+	draining  []*core.Instance  // Instances replaced by Reload, closed after DrainTimeout
+	poolNames map[string]string // Pool outbound tag to server name, nil outside pool mode
 }
 
+// pingTimeout bounds each URL probed by MeasurePing.
+const pingTimeout = 12 * time.Second
+
+// DrainTimeout is how long an instance replaced by Reload keeps serving
+// the connections it already accepted before being closed.
+const DrainTimeout = 30 * time.Second
+
 // global instance of XrayService
 var (
 	xrayService *XrayService
@@ -46,7 +67,7 @@ func (xs *XrayService) CoreName() string {
 
 // Start initializes and starts the Xray service using the provided configuration.
 // It manages memory and environment settings, with optional context support for cancellation.
-func (xs *XrayService) Start(ctx context.Context, opts global.StartOptions) error {
+func (xs *XrayService) Start(ctx context.Context, opts global.StartOptions) (err error) {
 	xs.mutex.Lock()
 	defer xs.mutex.Unlock()
 
@@ -54,6 +75,13 @@ func (xs *XrayService) Start(ctx context.Context, opts global.StartOptions) erro
 		return errors.New("failed: xray service is already running")
 	}
 
+	corestate.Publish(xs.CoreName(), corestate.Starting, nil)
+	defer func() {
+		if err != nil {
+			corestate.Publish(xs.CoreName(), corestate.Failed, err)
+		}
+	}()
+
 	// Initialize logger
 	log.StartLogger()
 
@@ -66,8 +94,13 @@ func (xs *XrayService) Start(ctx context.Context, opts global.StartOptions) erro
 		return fmt.Errorf("failed: unable to set memory limit: %v", err)
 	}
 
+	poolNames, err := applyPool(&opts)
+	if err != nil {
+		return err
+	}
+
 	// Load and initialize the Xray core instance
-	instance, err := xs.loadServer(ctx, opts.Config, opts.IsString, opts.ProxyPort)
+	instance, err := xs.loadServer(ctx, opts.Config, opts.IsString, opts.ProxyPort, opts.Auth)
 	if err != nil {
 		return fmt.Errorf("failed: unable to load Xray server: %v", err)
 	}
@@ -83,6 +116,8 @@ func (xs *XrayService) Start(ctx context.Context, opts global.StartOptions) erro
 	}
 
 	xs.instance = instance
+	xs.poolNames = poolNames
+	xs.sampler.Reset()
 	xs.isRunning = true
 
 	if err := FreeOSMemory(ctx); err != nil {
@@ -92,9 +127,108 @@ func (xs *XrayService) Start(ctx context.Context, opts global.StartOptions) erro
 	// Signal that the service is ready
 	close(xs.readyChan)
 
+	corestate.Publish(xs.CoreName(), corestate.Running, nil)
 	return nil
 }
 
+// Reload replaces the running instance with one built from opts without
+// releasing the SOCKS port for longer than it takes to rebind it. The old
+// instance stops accepting, keeps its established connections for
+// DrainTimeout and is then closed.
+func (xs *XrayService) Reload(ctx context.Context, opts global.StartOptions) (err error) {
+	xs.mutex.Lock()
+	defer xs.mutex.Unlock()
+
+	if !xs.isRunning || xs.instance == nil {
+		return errors.New("failed: xray service is not running, please start it first")
+	}
+
+	corestate.Publish(xs.CoreName(), corestate.Starting, nil)
+	defer func() {
+		if err != nil {
+			corestate.Publish(xs.CoreName(), corestate.Failed, err)
+			if xs.isRunning {
+				// The previous config is still serving.
+				corestate.Publish(xs.CoreName(), corestate.Running, nil)
+			}
+		}
+	}()
+
+	poolNames, err := applyPool(&opts)
+	if err != nil {
+		return err
+	}
+
+	instance, err := xs.loadServer(ctx, opts.Config, opts.IsString, opts.ProxyPort, opts.Auth)
+	if err != nil {
+		return fmt.Errorf("failed: unable to load Xray server: %v", err)
+	}
+
+	old := xs.instance
+	handlers, canDrain := inboundHandlers(old)
+	if canDrain {
+		// Free the listeners only; accepted connections keep running.
+		for _, h := range handlers {
+			_ = h.Close()
+		}
+	} else {
+		_ = old.Close()
+	}
+
+	if err = instance.Start(); err != nil {
+		_ = instance.Close()
+		if canDrain {
+			for _, h := range handlers {
+				_ = h.Start()
+			}
+			return fmt.Errorf("failed: unable to start Xray instance, keeping previous config: %v", err)
+		}
+		// The old instance is gone, so the service is down.
+		conntrack.CloseAll(xs.CoreName())
+		xs.instance = nil
+		xs.poolNames = nil
+		xs.isRunning = false
+		xs.readyChan = make(chan struct{})
+		return fmt.Errorf("failed: unable to start Xray instance: %v", err)
+	}
+
+	xs.instance = instance
+	xs.poolNames = poolNames
+	if canDrain {
+		xs.draining = append(xs.draining, old)
+		time.AfterFunc(DrainTimeout, func() { xs.closeDrained(old) })
+	}
+
+	corestate.Publish(xs.CoreName(), corestate.Running, nil)
+	return nil
+}
+
+// inboundHandlers lists the inbound handlers of instance, reporting false
+// when the inbound manager cannot enumerate them.
+func inboundHandlers(instance *core.Instance) ([]inbound.Handler, bool) {
+	manager, ok := instance.GetFeature(inbound.ManagerType()).(interface {
+		ListHandlers(context.Context) []inbound.Handler
+	})
+	if !ok {
+		return nil, false
+	}
+	return manager.ListHandlers(context.Background()), true
+}
+
+// closeDrained closes an instance replaced by Reload unless Stop already did.
+func (xs *XrayService) closeDrained(old *core.Instance) {
+	xs.mutex.Lock()
+	defer xs.mutex.Unlock()
+
+	for i, instance := range xs.draining {
+		if instance == old {
+			xs.draining = append(xs.draining[:i], xs.draining[i+1:]...)
+			_ = old.Close()
+			return
+		}
+	}
+}
+
 // Stop gracefully shuts down the Xray service.
 func (xs *XrayService) Stop(ctx context.Context) error {
 	xs.mutex.Lock()
@@ -104,18 +238,31 @@ func (xs *XrayService) Stop(ctx context.Context) error {
 		return nil
 	}
 
+	corestate.Publish(xs.CoreName(), corestate.Stopping, nil)
+
 	// Stop/Clean logger
 	log.StopLogger()
 
+	for _, old := range xs.draining {
+		_ = old.Close()
+	}
+	xs.draining = nil
+
 	if xs.instance != nil {
 		if err := xs.instance.Close(); err != nil {
-			return fmt.Errorf("failed: unable to close Xray instance: %v", err)
+			err = fmt.Errorf("failed: unable to close Xray instance: %v", err)
+			corestate.Publish(xs.CoreName(), corestate.Failed, err)
+			return err
 		}
 	}
+	conntrack.CloseAll(xs.CoreName())
 	xs.instance = nil
+	xs.poolNames = nil
 	xs.isRunning = false
 	// Reset ready channel for next start
 	xs.readyChan = make(chan struct{})
+
+	corestate.Publish(xs.CoreName(), corestate.Stopped, nil)
 	return nil
 }
 
@@ -132,7 +279,7 @@ func (xs *XrayService) IsRunning() bool {
 }
 
 // MeasurePing measures the delay between the Xray instance and given URLs.
-// Returns ping results for given URLs in milliseconds(ms).
+// Returns one result per URL with per-phase timings in milliseconds(ms).
 func (xs *XrayService) MeasurePing(ctx context.Context, urls []string) (*proxycoreproto.MeasurePingResponse, error) {
 	if ctx == nil {
 		return nil, errors.New("failed: context cannot be nil")
@@ -163,82 +310,91 @@ func (xs *XrayService) MeasurePing(ctx context.Context, urls []string) (*proxyco
 		return nil, errors.New("failed: xray instance is nil despite service being marked as running")
 	}
 
-	tr := &http.Transport{
-		TLSHandshakeTimeout: 6 * time.Second,
-		DisableKeepAlives:   true,
-		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
-			if ctx == nil {
-				return nil, errors.New("failed: context cannot be nil in DialContext")
-			}
-			if network == "" || addr == "" {
-				return nil, errors.New("failed: network or address cannot be empty")
-			}
-
-			dest, err := xraynet.ParseDestination(fmt.Sprintf("%s:%s", network, addr))
-			if err != nil {
-				return nil, fmt.Errorf("failed: unable to parse destination: %v", err)
-			}
-
-			conn, err := core.Dial(ctx, xs.instance, dest)
-			if err != nil {
-				return nil, fmt.Errorf("failed: unable to dial: %v", err)
-			}
-			return conn, nil
-		},
-		ResponseHeaderTimeout: 10 * time.Second,
-		IdleConnTimeout:       30 * time.Second,
-		MaxIdleConnsPerHost:   10,
-	}
-
-	client := &http.Client{
-		Transport: tr,
-		Timeout:   12 * time.Second,
+	dial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dest, err := xraynet.ParseDestination(fmt.Sprintf("%s:%s", network, addr))
+		if err != nil {
+			return nil, fmt.Errorf("failed: unable to parse destination: %v", err)
+		}
+		return core.Dial(ctx, xs.instance, dest)
 	}
-	defer tr.CloseIdleConnections()
 
+	// A failing URL is reported in its result and does not stop the others.
 	results := make([]*proxycoreproto.PingResult, 0, len(urls))
 	for _, url := range urls {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-			if len(url) == 0 {
-				url = "https://www.google.com/generate_204"
-			}
-
-			req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-			if err != nil {
-				return nil, fmt.Errorf("failed: invalid request for URL %s: %v", url, err)
-			}
+		res := latency.Measure(ctx, dial, url, pingTimeout)
+		results = append(results, res.PingResult())
+	}
 
-			start := time.Now()
-			resp, err := client.Do(req)
-			if err != nil {
-				return nil, fmt.Errorf("failed: request failed for URL %s: %v", url, err)
-			}
+	return &proxycoreproto.MeasurePingResponse{
+		Results: results,
+	}, nil
+}
 
-			if resp != nil {
-				defer resp.Body.Close()
-				if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-					return nil, fmt.Errorf("failed: unexpected status code %d for URL %s", resp.StatusCode, url)
-				}
-			}
+// TrafficStats sums the traffic counters of the outbounds that go through a
+// proxy server; direct, blocked and DNS traffic is left out. When reset is
+// true the counters are zeroed after being read.
+func (xs *XrayService) TrafficStats(reset bool) (*proxycoreproto.TrafficStatsResponse, error) {
+	xs.mutex.Lock()
+	defer xs.mutex.Unlock()
 
-			delay := time.Since(start).Milliseconds()
-			results = append(results, &proxycoreproto.PingResult{
-				Url:   fmt.Sprintf("%s (%dms)", url, delay),
-				Delay: delay,
-			})
-		}
+	if !xs.isRunning || xs.instance == nil {
+		return nil, errors.New("failed: xray service is not running, please start it first")
 	}
 
-	if len(results) == 0 {
-		return nil, errors.New("failed: no valid results obtained from any URL")
+	manager, ok := xs.instance.GetFeature(stats.ManagerType()).(interface {
+		VisitCounters(func(string, stats.Counter) bool)
+	})
+	if !ok {
+		return nil, errors.New("failed: stats manager is not available")
 	}
 
-	return &proxycoreproto.MeasurePingResponse{
-		Results: results,
-	}, nil
+	local := localOutbounds(xs.instance)
+	var up, down int64
+	manager.VisitCounters(func(name string, c stats.Counter) bool {
+		tag, ok := strings.CutPrefix(name, "outbound>>>")
+		if !ok {
+			return true
+		}
+		if tag, _, _ = strings.Cut(tag, ">>>"); local[tag] {
+			return true
+		}
+		var value int64
+		if reset {
+			value = c.Set(0)
+		} else {
+			value = c.Value()
+		}
+		switch {
+		case strings.HasSuffix(name, ">>>traffic>>>uplink"):
+			up += value
+		case strings.HasSuffix(name, ">>>traffic>>>downlink"):
+			down += value
+		}
+		return true
+	})
+
+	return xs.sampler.Sample(up, down, reset), nil
+}
+
+// localOutbounds returns the tags of the outbounds that never reach a proxy
+// server: freedom, blackhole and dns.
+func localOutbounds(instance *core.Instance) map[string]bool {
+	tags := make(map[string]bool)
+	manager, ok := instance.GetFeature(outbound.ManagerType()).(outbound.Manager)
+	if !ok {
+		return tags
+	}
+	for _, h := range manager.ListHandlers(context.Background()) {
+		p, ok := h.(interface{ GetOutbound() proxy.Outbound })
+		if !ok {
+			continue
+		}
+		switch p.GetOutbound().(type) {
+		case *freedom.Handler, *blackhole.Handler, *dns.Handler:
+			tags[h.Tag()] = true
+		}
+	}
+	return tags
 }
 
 func (xs *XrayService) FetchLogs() string {
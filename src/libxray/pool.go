@@ -0,0 +1,202 @@
+package libxray
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"segment/global"
+	"segment/latency"
+	"segment/proxycoreproto"
+
+	"github.com/GFW-knocker/Xray-core/app/observatory"
+	"github.com/GFW-knocker/Xray-core/core"
+	"github.com/GFW-knocker/Xray-core/features/extension"
+	"github.com/GFW-knocker/Xray-core/features/routing"
+)
+
+const (
+	poolTagPrefix   = "pool-"
+	poolBalancerTag = "pool"
+
+	defaultProbeInterval = 30 * time.Second
+)
+
+// buildPoolConfig generates a client config whose traffic is balanced over
+// the pool servers, which are share links or outbound JSON objects. An
+// observatory probes every server so the balancer can skip dead ones.
+// It also returns a display name for every pool outbound tag.
+func buildPoolConfig(pool *global.PoolOptions, port int32) (string, map[string]string, error) {
+	if len(pool.Servers) == 0 {
+		return "", nil, errors.New("failed: pool has no servers")
+	}
+
+	outbounds := make([]map[string]any, 0, len(pool.Servers))
+	names := make(map[string]string, len(pool.Servers))
+	for i, server := range pool.Servers {
+		tag := fmt.Sprintf("%s%d", poolTagPrefix, i)
+
+		var (
+			outbound map[string]any
+			name     string
+		)
+		if strings.HasPrefix(strings.TrimSpace(server), "{") {
+			if err := json.Unmarshal([]byte(server), &outbound); err != nil {
+				return "", nil, fmt.Errorf("failed: pool server %d is not valid JSON: %v", i, err)
+			}
+			name, _ = outbound["tag"].(string)
+		} else {
+			sl, err := ParseShareLink(server)
+			if err != nil {
+				return "", nil, fmt.Errorf("failed: pool server %d: %v", i, err)
+			}
+			if outbound, err = sl.outbound(); err != nil {
+				return "", nil, fmt.Errorf("failed: pool server %d: %v", i, err)
+			}
+			name = sl.Name
+		}
+		if name == "" {
+			name = tag
+		}
+		outbound["tag"] = tag
+		outbounds = append(outbounds, outbound)
+		names[tag] = name
+	}
+
+	strategy := pool.Strategy
+	if strategy == "" {
+		strategy = "leastPing"
+	}
+	probeURL := pool.ProbeURL
+	if probeURL == "" {
+		probeURL = latency.DefaultURL
+	}
+	interval := pool.ProbeInterval
+	if interval <= 0 {
+		interval = defaultProbeInterval
+	}
+
+	config := clientConfig(port, outbounds...)
+	selector := []string{poolTagPrefix}
+
+	switch strategy {
+	case "leastLoad":
+		// leastLoad ranks by the variance of repeated probes.
+		config["burstObservatory"] = map[string]any{
+			"subjectSelector": selector,
+			"pingConfig": map[string]any{
+				"destination": probeURL,
+				"interval":    interval.String(),
+				"timeout":     "5s",
+				"sampling":    3,
+			},
+		}
+	case "leastPing", "roundRobin", "random":
+		config["observatory"] = map[string]any{
+			"subjectSelector":   selector,
+			"probeURL":          probeURL,
+			"probeInterval":     interval.String(),
+			"enableConcurrency": true,
+		}
+	default:
+		return "", nil, fmt.Errorf("failed: unknown pool strategy %q", strategy)
+	}
+
+	routingConfig := config["routing"].(map[string]any)
+	routingConfig["balancers"] = []any{map[string]any{
+		"tag":         poolBalancerTag,
+		"selector":    selector,
+		"strategy":    map[string]any{"type": strategy},
+		"fallbackTag": poolTagPrefix + "0",
+	}}
+	routingConfig["rules"] = append(routingConfig["rules"].([]any), map[string]any{
+		"type":        "field",
+		"network":     "tcp,udp",
+		"balancerTag": poolBalancerTag,
+	})
+
+	out, err := json.Marshal(config)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed: unable to encode pool config: %v", err)
+	}
+	return string(out), names, nil
+}
+
+// applyPool replaces opts.Config with the generated pool config when pool
+// mode is requested and returns the pool outbound names.
+func applyPool(opts *global.StartOptions) (map[string]string, error) {
+	if opts.Pool == nil {
+		return nil, nil
+	}
+	config, names, err := buildPoolConfig(opts.Pool, opts.ProxyPort)
+	if err != nil {
+		return nil, err
+	}
+	opts.Config = config
+	opts.IsString = true
+	return names, nil
+}
+
+// OutboundStatus reports the observatory's view of every probed outbound and
+// the outbound the pool balancer currently picks.
+func (xs *XrayService) OutboundStatus() (*proxycoreproto.OutboundStatusResponse, error) {
+	xs.mutex.Lock()
+	defer xs.mutex.Unlock()
+
+	if !xs.isRunning || xs.instance == nil {
+		return nil, errors.New("failed: xray service is not running, please start it first")
+	}
+	return outboundStatus(xs.instance, xs.poolNames)
+}
+
+func outboundStatus(instance *core.Instance, names map[string]string) (*proxycoreproto.OutboundStatusResponse, error) {
+	resp := &proxycoreproto.OutboundStatusResponse{}
+
+	if pt, ok := instance.GetFeature(routing.RouterType()).(routing.BalancerPrincipleTarget); ok && names != nil {
+		if targets, err := pt.GetPrincipleTarget(poolBalancerTag); err == nil && len(targets) > 0 {
+			resp.Selected = targets[0]
+		}
+	}
+
+	obs, ok := instance.GetFeature(extension.ObservatoryType()).(extension.Observatory)
+	if !ok || obs == nil {
+		return resp, nil
+	}
+	msg, err := obs.GetObservation(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed: unable to read observatory: %v", err)
+	}
+	result, ok := msg.(*observatory.ObservationResult)
+	if !ok {
+		return resp, nil
+	}
+
+	for _, s := range result.Status {
+		name := names[s.OutboundTag]
+		if name == "" {
+			name = s.OutboundTag
+		}
+		health := &proxycoreproto.OutboundHealth{
+			Tag:   s.OutboundTag,
+			Name:  name,
+			Alive: s.Alive,
+			Delay: s.Delay,
+			Error: s.LastErrorReason,
+		}
+		if !s.Alive {
+			// The observatory parks dead outbounds at a huge sentinel delay.
+			health.Delay = -1
+		}
+		if s.LastSeenTime > 0 {
+			health.LastSeenTime = s.LastSeenTime * 1000
+		}
+		if s.LastTryTime > 0 {
+			health.LastTryTime = s.LastTryTime * 1000
+		}
+		resp.Outbounds = append(resp.Outbounds, health)
+	}
+	return resp, nil
+}
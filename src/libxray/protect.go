@@ -0,0 +1,13 @@
+package libxray
+
+import (
+	"segment/global"
+
+	"github.com/GFW-knocker/Xray-core/common"
+	"github.com/GFW-knocker/Xray-core/transport/internet"
+)
+
+func init() {
+	// Xray runs the controller on every outbound socket before it connects.
+	common.Must(internet.RegisterDialerController(global.ProtectSocket))
+}
@@ -6,13 +6,16 @@ import (
 	"os"
 	"strings"
 
-	"github.com/GFW-knocker/Xray-core/common/cmdarg"
+	"segment/global"
+	log "segment/libxray/slog"
+
+	xlog "github.com/GFW-knocker/Xray-core/common/log"
 	"github.com/GFW-knocker/Xray-core/core"
 	"github.com/GFW-knocker/Xray-core/infra/conf/serial"
 )
 
-// loadServer initializes the Xray core server based on the configuration provided and replaces the inbound socks port.
-func (xs *XrayService) loadServer(ctx context.Context, config string, isStr bool, port int32) (*core.Instance, error) {
+// loadServer initializes the Xray core server based on the configuration provided and patches the inbound socks port and credentials.
+func (xs *XrayService) loadServer(ctx context.Context, config string, isStr bool, port int32, auth *global.ProxyAuth) (*core.Instance, error) {
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err() // Handle context cancellation
@@ -23,10 +26,15 @@ func (xs *XrayService) loadServer(ctx context.Context, config string, isStr bool
 	var err error
 
 	if isStr {
-		// Replace the inbound socks port in the string configuration
-		config, err = replaceInboundSocksPort(config, port)
+		// Patch the inbound socks port and credentials in the string configuration
+		config, err = patchInboundSocks(config, port, auth)
+		if err != nil {
+			return nil, fmt.Errorf("failed: unable to patch inbound socks: %v", err)
+		}
+
+		config, err = enableTrafficStats(config)
 		if err != nil {
-			return nil, fmt.Errorf("failed: unable to replace inbound socks port: %v", err)
+			return nil, err
 		}
 
 		// Parse the modified configuration string as JSON
@@ -35,31 +43,34 @@ func (xs *XrayService) loadServer(ctx context.Context, config string, isStr bool
 			return nil, fmt.Errorf("failed: unable to parse JSON config: %v", err)
 		}
 	} else {
-		// Read the file content and replace the inbound socks port
+		// Read the file content and patch the inbound socks port and credentials
 		fileContent, err := os.ReadFile(config)
 		if err != nil {
 			return nil, fmt.Errorf("failed: unable to read config file: %v", err)
 		}
 
-		modifiedConfig, err := replaceInboundSocksPort(string(fileContent), port)
+		modifiedConfig, err := patchInboundSocks(string(fileContent), port, auth)
 		if err != nil {
-			return nil, fmt.Errorf("failed: unable to replace inbound socks port: %v", err)
+			return nil, fmt.Errorf("failed: unable to patch inbound socks: %v", err)
 		}
 
-		// Write the modified content back to the file (optional, if needed)
-		err = os.WriteFile(config, []byte(modifiedConfig), 0644)
+		modifiedConfig, err = enableTrafficStats(modifiedConfig)
 		if err != nil {
-			return nil, fmt.Errorf("failed: unable to write modified config file: %v", err)
+			return nil, err
 		}
 
-		// Load the configuration from the modified file
-		file := cmdarg.Arg{config}
-		jsonConfig, err = core.LoadConfig("json", file)
+		// Load the patched content without writing it back, the file must not hold the credentials
+		jsonConfig, err = serial.LoadJSONConfig(strings.NewReader(modifiedConfig))
 		if err != nil {
-			return nil, fmt.Errorf("failed: unable to load config from file: %v", err)
+			return nil, fmt.Errorf("failed: unable to parse JSON config: %v", err)
 		}
 	}
 
+	if reason := enableConnTracking(jsonConfig); reason != "" {
+		msg := &xlog.GeneralMessage{Severity: xlog.Severity_Warning, Content: "connection tracking is off, listConnections will not show xray connections: " + reason}
+		log.WriteLog(msg, msg.String())
+	}
+
 	// Initialize the Xray core server with the modified configuration
 	server, err := core.New(jsonConfig)
 	if err != nil {
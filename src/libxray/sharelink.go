@@ -0,0 +1,449 @@
+package libxray
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"segment/global"
+)
+
+// ShareLink is a proxy server decoded from a vless://, vmess://, trojan://
+// or ss:// URI.
+type ShareLink struct {
+	Protocol string // vless, vmess, trojan or shadowsocks
+	Name     string // Remark from the URI fragment or the vmess "ps" field
+	Address  string
+	Port     int
+
+	ID       string // vless/vmess user id
+	AlterID  int    // vmess only
+	Password string // trojan and shadowsocks
+	Method   string // shadowsocks cipher or vmess security
+	Flow     string // vless only
+
+	Query url.Values // Transport and security parameters in share-link form
+}
+
+// privateCIDRs are routed to the direct outbound without needing geoip.dat.
+var privateCIDRs = []string{
+	"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8",
+	"169.254.0.0/16", "172.16.0.0/12", "192.168.0.0/16",
+	"::1/128", "fc00::/7", "fe80::/10",
+}
+
+// ConvertShareLink turns a share link into a complete client config with a
+// socks inbound on port, proxy/direct/block outbounds and basic routing.
+// It returns the config JSON and the link's remark.
+func ConvertShareLink(link string, port int32) (string, string, error) {
+	sl, err := ParseShareLink(link)
+	if err != nil {
+		return "", "", err
+	}
+
+	outbound, err := sl.outbound()
+	if err != nil {
+		return "", "", err
+	}
+
+	config := clientConfig(port, outbound)
+	out, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return "", "", fmt.Errorf("failed: unable to encode config: %v", err)
+	}
+	return string(out), sl.Name, nil
+}
+
+// clientConfig is the skeleton shared by generated configs: a socks inbound
+// on port, the given proxy outbounds followed by direct and block, and
+// private destinations routed direct.
+func clientConfig(port int32, proxies ...map[string]any) map[string]any {
+	outbounds := make([]any, 0, len(proxies)+2)
+	for _, p := range proxies {
+		outbounds = append(outbounds, p)
+	}
+	outbounds = append(outbounds,
+		map[string]any{"tag": "direct", "protocol": "freedom"},
+		map[string]any{"tag": "block", "protocol": "blackhole"},
+	)
+
+	return map[string]any{
+		"log": map[string]any{"loglevel": "warning"},
+		"inbounds": []any{map[string]any{
+			"tag":      "socks-in",
+			"listen":   "127.0.0.1",
+			"port":     port,
+			"protocol": "socks",
+			"settings": map[string]any{"auth": "noauth", "udp": true},
+			"sniffing": map[string]any{
+				"enabled":      true,
+				"destOverride": []string{"http", "tls", "quic"},
+				"routeOnly":    true,
+			},
+		}},
+		"outbounds": outbounds,
+		"routing": map[string]any{
+			"domainStrategy": "IPIfNonMatch",
+			"rules": []any{
+				map[string]any{"type": "field", "ip": privateCIDRs, "outboundTag": "direct"},
+				map[string]any{"type": "field", "domain": []string{"localhost"}, "outboundTag": "direct"},
+			},
+		},
+	}
+}
+
+// ParseShareLink decodes a single share link.
+func ParseShareLink(link string) (*ShareLink, error) {
+	link = strings.TrimSpace(link)
+	scheme, _, ok := strings.Cut(link, "://")
+	if !ok {
+		return nil, fmt.Errorf("failed: not a share link: %q", link)
+	}
+
+	switch strings.ToLower(scheme) {
+	case "vless":
+		return parseVless(link)
+	case "vmess":
+		return parseVmess(link)
+	case "trojan":
+		return parseTrojan(link)
+	case "ss":
+		return parseShadowsocks(link)
+	default:
+		return nil, fmt.Errorf("failed: unsupported share link scheme %q", scheme)
+	}
+}
+
+func parseVless(link string) (*ShareLink, error) {
+	u, err := parseURL(link)
+	if err != nil {
+		return nil, err
+	}
+	sl := &ShareLink{Protocol: "vless", ID: u.User.Username(), Query: u.Query()}
+	if err := sl.setEndpoint(u); err != nil {
+		return nil, err
+	}
+	if sl.ID == "" {
+		return nil, fmt.Errorf("failed: vless link has no user id")
+	}
+	sl.Flow = sl.Query.Get("flow")
+	sl.Method = sl.Query.Get("encryption")
+	return sl, nil
+}
+
+func parseTrojan(link string) (*ShareLink, error) {
+	u, err := parseURL(link)
+	if err != nil {
+		return nil, err
+	}
+	sl := &ShareLink{Protocol: "trojan", Password: u.User.Username(), Query: u.Query()}
+	if err := sl.setEndpoint(u); err != nil {
+		return nil, err
+	}
+	if sl.Password == "" {
+		return nil, fmt.Errorf("failed: trojan link has no password")
+	}
+	// Trojan is TLS unless the link says otherwise.
+	if sl.Query.Get("security") == "" {
+		sl.Query.Set("security", "tls")
+	}
+	return sl, nil
+}
+
+// parseVmess handles the v2rayN format: base64 encoded JSON.
+func parseVmess(link string) (*ShareLink, error) {
+	payload := link[len("vmess://"):]
+	if i := strings.IndexByte(payload, '#'); i >= 0 {
+		payload = payload[:i]
+	}
+	raw, err := global.DecodeBase64(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed: invalid vmess link: %v", err)
+	}
+
+	var v map[string]any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, fmt.Errorf("failed: invalid vmess JSON: %v", err)
+	}
+	str := func(key string) string {
+		switch val := v[key].(type) {
+		case string:
+			return val
+		case float64:
+			return strconv.FormatFloat(val, 'f', -1, 64)
+		}
+		return ""
+	}
+
+	port, err := strconv.Atoi(str("port"))
+	if err != nil || port <= 0 || port > 65535 {
+		return nil, fmt.Errorf("failed: invalid vmess port %q", str("port"))
+	}
+	aid, _ := strconv.Atoi(str("aid"))
+
+	q := url.Values{}
+	for key, param := range map[string]string{
+		"net": "type", "type": "headerType", "host": "host", "path": "path",
+		"tls": "security", "sni": "sni", "alpn": "alpn", "fp": "fp",
+	} {
+		if val := str(key); val != "" {
+			q.Set(param, val)
+		}
+	}
+	if q.Get("type") == "grpc" {
+		q.Set("serviceName", q.Get("path"))
+		q.Set("mode", q.Get("headerType"))
+		q.Del("path")
+		q.Del("headerType")
+	}
+
+	sl := &ShareLink{
+		Protocol: "vmess",
+		Name:     str("ps"),
+		Address:  str("add"),
+		Port:     port,
+		ID:       str("id"),
+		AlterID:  aid,
+		Method:   str("scy"),
+		Query:    q,
+	}
+	if sl.Address == "" || sl.ID == "" {
+		return nil, fmt.Errorf("failed: vmess link is missing address or id")
+	}
+	return sl, nil
+}
+
+// parseShadowsocks accepts SIP002 (ss://base64(method:password)@host:port)
+// as well as the legacy ss://base64(method:password@host:port) form.
+func parseShadowsocks(link string) (*ShareLink, error) {
+	rest := link[len("ss://"):]
+	var name string
+	if i := strings.IndexByte(rest, '#'); i >= 0 {
+		name, _ = url.PathUnescape(rest[i+1:])
+		rest = rest[:i]
+	}
+
+	if !strings.Contains(rest, "@") {
+		raw, err := global.DecodeBase64(strings.SplitN(rest, "?", 2)[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed: invalid shadowsocks link: %v", err)
+		}
+		rest = string(raw)
+	}
+
+	u, err := url.Parse("ss://" + rest)
+	if err != nil {
+		return nil, fmt.Errorf("failed: invalid shadowsocks link: %v", err)
+	}
+	sl := &ShareLink{Protocol: "shadowsocks", Name: name, Query: u.Query()}
+	if err := sl.setEndpoint(u); err != nil {
+		return nil, err
+	}
+	if sl.Query.Get("plugin") != "" {
+		return nil, fmt.Errorf("failed: shadowsocks plugins are not supported")
+	}
+
+	if password, ok := u.User.Password(); ok {
+		sl.Method, sl.Password = u.User.Username(), password
+	} else {
+		userinfo, err := global.DecodeBase64(u.User.Username())
+		if err != nil {
+			return nil, fmt.Errorf("failed: invalid shadowsocks user info: %v", err)
+		}
+		method, password, ok := strings.Cut(string(userinfo), ":")
+		if !ok {
+			return nil, fmt.Errorf("failed: shadowsocks user info must be method:password")
+		}
+		sl.Method, sl.Password = method, password
+	}
+	if sl.Method == "" || sl.Password == "" {
+		return nil, fmt.Errorf("failed: shadowsocks link is missing method or password")
+	}
+	sl.Method = strings.ToLower(sl.Method)
+	return sl, nil
+}
+
+func parseURL(link string) (*url.URL, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return nil, fmt.Errorf("failed: invalid share link: %v", err)
+	}
+	if u.User == nil {
+		return nil, fmt.Errorf("failed: share link has no credentials")
+	}
+	return u, nil
+}
+
+func (sl *ShareLink) setEndpoint(u *url.URL) error {
+	host := u.Hostname()
+	port, err := strconv.Atoi(u.Port())
+	if host == "" || err != nil || port <= 0 || port > 65535 {
+		return fmt.Errorf("failed: share link has an invalid server address %q", u.Host)
+	}
+	sl.Address, sl.Port = host, port
+	if sl.Name == "" && u.Fragment != "" {
+		sl.Name = u.Fragment
+	}
+	return nil
+}
+
+// Validate reports whether the link can be turned into an Xray outbound.
+// It fails for protocols and transports this core does not support.
+func (sl *ShareLink) Validate() error {
+	_, err := sl.outbound()
+	return err
+}
+
+// outbound builds the "proxy" outbound for the link.
+func (sl *ShareLink) outbound() (map[string]any, error) {
+	out := map[string]any{"tag": "proxy", "protocol": sl.Protocol}
+
+	switch sl.Protocol {
+	case "vless":
+		encryption := sl.Method
+		if encryption == "" {
+			encryption = "none"
+		}
+		user := map[string]any{"id": sl.ID, "encryption": encryption}
+		if sl.Flow != "" {
+			user["flow"] = sl.Flow
+		}
+		out["settings"] = map[string]any{"vnext": []any{map[string]any{
+			"address": sl.Address, "port": sl.Port, "users": []any{user},
+		}}}
+	case "vmess":
+		security := sl.Method
+		if security == "" {
+			security = "auto"
+		}
+		out["settings"] = map[string]any{"vnext": []any{map[string]any{
+			"address": sl.Address, "port": sl.Port,
+			"users": []any{map[string]any{"id": sl.ID, "alterId": sl.AlterID, "security": security}},
+		}}}
+	case "trojan":
+		out["settings"] = map[string]any{"servers": []any{map[string]any{
+			"address": sl.Address, "port": sl.Port, "password": sl.Password,
+		}}}
+	case "shadowsocks":
+		out["settings"] = map[string]any{"servers": []any{map[string]any{
+			"address": sl.Address, "port": sl.Port, "method": sl.Method, "password": sl.Password,
+		}}}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("failed: unsupported protocol %q", sl.Protocol)
+	}
+
+	stream, err := sl.streamSettings()
+	if err != nil {
+		return nil, err
+	}
+	out["streamSettings"] = stream
+	return out, nil
+}
+
+// streamSettings maps the share-link transport and security parameters
+// onto Xray's streamSettings object.
+func (sl *ShareLink) streamSettings() (map[string]any, error) {
+	q := sl.Query
+	network := strings.ToLower(q.Get("type"))
+	switch network {
+	case "", "tcp":
+		network = "raw"
+	case "splithttp":
+		network = "xhttp"
+	}
+
+	stream := map[string]any{"network": network}
+	host, path := q.Get("host"), q.Get("path")
+
+	switch network {
+	case "raw":
+		if q.Get("headerType") == "http" {
+			request := map[string]any{}
+			if path != "" {
+				request["path"] = strings.Split(path, ",")
+			}
+			if host != "" {
+				request["headers"] = map[string]any{"Host": strings.Split(host, ",")}
+			}
+			stream["rawSettings"] = map[string]any{"header": map[string]any{"type": "http", "request": request}}
+		}
+	case "ws":
+		stream["wsSettings"] = map[string]any{"path": path, "host": host}
+	case "httpupgrade":
+		stream["httpupgradeSettings"] = map[string]any{"path": path, "host": host}
+	case "xhttp":
+		settings := map[string]any{"path": path, "host": host}
+		if mode := q.Get("mode"); mode != "" {
+			settings["mode"] = mode
+		}
+		if extra := q.Get("extra"); extra != "" && json.Valid([]byte(extra)) {
+			settings["extra"] = json.RawMessage(extra)
+		}
+		stream["xhttpSettings"] = settings
+	case "grpc":
+		stream["grpcSettings"] = map[string]any{
+			"serviceName": q.Get("serviceName"),
+			"authority":   q.Get("authority"),
+			"multiMode":   q.Get("mode") == "multi",
+		}
+	case "kcp":
+		settings := map[string]any{"header": map[string]any{"type": orDefault(q.Get("headerType"), "none")}}
+		if seed := q.Get("seed"); seed != "" {
+			settings["seed"] = seed
+		}
+		stream["kcpSettings"] = settings
+	default:
+		return nil, fmt.Errorf("failed: unsupported transport %q", network)
+	}
+
+	serverName := orDefault(q.Get("sni"), host)
+	if serverName == "" && net.ParseIP(sl.Address) == nil {
+		serverName = sl.Address
+	}
+	var alpn []string
+	if v := q.Get("alpn"); v != "" {
+		alpn = strings.Split(v, ",")
+	}
+
+	switch security := strings.ToLower(q.Get("security")); security {
+	case "", "none":
+	case "tls":
+		tls := map[string]any{
+			"serverName":    serverName,
+			"allowInsecure": q.Get("allowInsecure") == "1" || q.Get("insecure") == "1",
+			"fingerprint":   orDefault(q.Get("fp"), "chrome"),
+		}
+		if alpn != nil {
+			tls["alpn"] = alpn
+		}
+		stream["security"] = "tls"
+		stream["tlsSettings"] = tls
+	case "reality":
+		if q.Get("pbk") == "" {
+			return nil, fmt.Errorf("failed: reality link has no public key")
+		}
+		stream["security"] = "reality"
+		stream["realitySettings"] = map[string]any{
+			"serverName":  serverName,
+			"fingerprint": orDefault(q.Get("fp"), "chrome"),
+			"publicKey":   q.Get("pbk"),
+			"shortId":     q.Get("sid"),
+			"spiderX":     q.Get("spx"),
+		}
+	default:
+		return nil, fmt.Errorf("failed: unsupported security %q", security)
+	}
+
+	return stream, nil
+}
+
+func orDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,184 @@
+package libxray
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testUUID = "b831381d-6324-4d53-ad4f-8cda48b30811"
+
+func b64(s string) string {
+	return base64.StdEncoding.EncodeToString([]byte(s))
+}
+
+func TestConvertShareLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		link     string
+		remark   string
+		outbound string // The "proxy" outbound as JSON
+	}{
+		{
+			name:   "vless reality",
+			link:   "vless://" + testUUID + "@example.com:443?security=reality&pbk=PUBKEY&sid=ab12&sni=www.microsoft.com&fp=firefox&flow=xtls-rprx-vision&type=tcp#Reality",
+			remark: "Reality",
+			outbound: `{"tag": "proxy", "protocol": "vless",
+				"settings": {"vnext": [{"address": "example.com", "port": 443,
+					"users": [{"id": "` + testUUID + `", "encryption": "none", "flow": "xtls-rprx-vision"}]}]},
+				"streamSettings": {"network": "raw", "security": "reality",
+					"realitySettings": {"serverName": "www.microsoft.com", "fingerprint": "firefox",
+						"publicKey": "PUBKEY", "shortId": "ab12", "spiderX": ""}}}`,
+		},
+		{
+			name: "vless ws tls",
+			link: "vless://" + testUUID + "@1.2.3.4:443?type=ws&path=%2Fws&host=cdn.example.com&security=tls&alpn=h2,http/1.1",
+			outbound: `{"tag": "proxy", "protocol": "vless",
+				"settings": {"vnext": [{"address": "1.2.3.4", "port": 443,
+					"users": [{"id": "` + testUUID + `", "encryption": "none"}]}]},
+				"streamSettings": {"network": "ws", "wsSettings": {"path": "/ws", "host": "cdn.example.com"},
+					"security": "tls", "tlsSettings": {"serverName": "cdn.example.com", "allowInsecure": false,
+						"fingerprint": "chrome", "alpn": ["h2", "http/1.1"]}}}`,
+		},
+		{
+			name:   "vless grpc",
+			link:   "vless://" + testUUID + "@grpc.example.com:443?type=grpc&serviceName=svc&mode=multi&security=tls#gRPC%20node",
+			remark: "gRPC node",
+			outbound: `{"tag": "proxy", "protocol": "vless",
+				"settings": {"vnext": [{"address": "grpc.example.com", "port": 443,
+					"users": [{"id": "` + testUUID + `", "encryption": "none"}]}]},
+				"streamSettings": {"network": "grpc", "grpcSettings": {"serviceName": "svc", "authority": "", "multiMode": true},
+					"security": "tls", "tlsSettings": {"serverName": "grpc.example.com", "allowInsecure": false,
+						"fingerprint": "chrome"}}}`,
+		},
+		{
+			name: "vmess ws tls",
+			link: "vmess://" + b64(`{"v": "2", "ps": "VMess", "add": "vm.example.com", "port": "8443", "id": "`+testUUID+`",
+				"aid": "0", "scy": "", "net": "ws", "type": "none", "host": "vm.example.com", "path": "/vm", "tls": "tls"}`),
+			remark: "VMess",
+			outbound: `{"tag": "proxy", "protocol": "vmess",
+				"settings": {"vnext": [{"address": "vm.example.com", "port": 8443,
+					"users": [{"id": "` + testUUID + `", "alterId": 0, "security": "auto"}]}]},
+				"streamSettings": {"network": "ws", "wsSettings": {"path": "/vm", "host": "vm.example.com"},
+					"security": "tls", "tlsSettings": {"serverName": "vm.example.com", "allowInsecure": false,
+						"fingerprint": "chrome"}}}`,
+		},
+		{
+			name: "vmess grpc numeric port",
+			link: "vmess://" + b64(`{"ps": "gRPC", "add": "10.0.0.1", "port": 443, "id": "`+testUUID+`",
+				"aid": 2, "scy": "aes-128-gcm", "net": "grpc", "type": "gun", "path": "svc"}`),
+			remark: "gRPC",
+			outbound: `{"tag": "proxy", "protocol": "vmess",
+				"settings": {"vnext": [{"address": "10.0.0.1", "port": 443,
+					"users": [{"id": "` + testUUID + `", "alterId": 2, "security": "aes-128-gcm"}]}]},
+				"streamSettings": {"network": "grpc", "grpcSettings": {"serviceName": "svc", "authority": "", "multiMode": false}}}`,
+		},
+		{
+			name:   "trojan defaults to tls",
+			link:   "trojan://secret@tr.example.com:443?sni=sni.example.com#Trojan",
+			remark: "Trojan",
+			outbound: `{"tag": "proxy", "protocol": "trojan",
+				"settings": {"servers": [{"address": "tr.example.com", "port": 443, "password": "secret"}]},
+				"streamSettings": {"network": "raw", "security": "tls",
+					"tlsSettings": {"serverName": "sni.example.com", "allowInsecure": false, "fingerprint": "chrome"}}}`,
+		},
+		{
+			name:   "shadowsocks sip002",
+			link:   "ss://" + b64("AES-256-GCM:secret") + "@ss.example.com:8388#SS%20node",
+			remark: "SS node",
+			outbound: `{"tag": "proxy", "protocol": "shadowsocks",
+				"settings": {"servers": [{"address": "ss.example.com", "port": 8388,
+					"method": "aes-256-gcm", "password": "secret"}]}}`,
+		},
+		{
+			name: "shadowsocks sip002 plain user info",
+			link: "ss://2022-blake3-aes-128-gcm:cGFzc3dvcmQ%3D@ss.example.com:8388",
+			outbound: `{"tag": "proxy", "protocol": "shadowsocks",
+				"settings": {"servers": [{"address": "ss.example.com", "port": 8388,
+					"method": "2022-blake3-aes-128-gcm", "password": "cGFzc3dvcmQ="}]}}`,
+		},
+		{
+			name:   "shadowsocks legacy",
+			link:   "ss://" + b64("chacha20-ietf-poly1305:secret@1.2.3.4:8388") + "#Legacy",
+			remark: "Legacy",
+			outbound: `{"tag": "proxy", "protocol": "shadowsocks",
+				"settings": {"servers": [{"address": "1.2.3.4", "port": 8388,
+					"method": "chacha20-ietf-poly1305", "password": "secret"}]}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, remark, err := ConvertShareLink(tt.link, 10808)
+			if err != nil {
+				t.Fatalf("ConvertShareLink: %v", err)
+			}
+			if remark != tt.remark {
+				t.Errorf("remark = %q, want %q", remark, tt.remark)
+			}
+
+			var parsed struct {
+				Inbounds  []map[string]any `json:"inbounds"`
+				Outbounds []any            `json:"outbounds"`
+			}
+			if err := json.Unmarshal([]byte(config), &parsed); err != nil {
+				t.Fatalf("config is not JSON: %v", err)
+			}
+			if len(parsed.Inbounds) != 1 || parsed.Inbounds[0]["port"] != float64(10808) {
+				t.Errorf("inbounds = %v, want one on port 10808", parsed.Inbounds)
+			}
+			if len(parsed.Outbounds) != 3 {
+				t.Fatalf("got %d outbounds, want proxy, direct and block", len(parsed.Outbounds))
+			}
+
+			var want any
+			if err := json.Unmarshal([]byte(tt.outbound), &want); err != nil {
+				t.Fatalf("bad want JSON: %v", err)
+			}
+			if !reflect.DeepEqual(parsed.Outbounds[0], want) {
+				got, _ := json.Marshal(parsed.Outbounds[0])
+				t.Errorf("outbound =\n%s\nwant\n%s", got, tt.outbound)
+			}
+		})
+	}
+}
+
+func TestConvertShareLinkErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		link string
+		want string // Substring of the error
+	}{
+		{"hysteria2", "hysteria2://secret@hy.example.com:443?sni=hy.example.com", "unsupported share link scheme"},
+		{"hy2 alias", "hy2://secret@hy.example.com:443", "unsupported share link scheme"},
+		{"not a link", "example.com:443", "not a share link"},
+		{"unknown scheme", "socks://user@example.com:1080", "unsupported share link scheme"},
+		{"vless without id", "vless://@example.com:443", "no user id"},
+		{"vless without credentials", "vless://example.com:443", "no credentials"},
+		{"port out of range", "vless://" + testUUID + "@example.com:70000", "invalid server address"},
+		{"missing port", "trojan://secret@example.com", "invalid server address"},
+		{"reality without key", "vless://" + testUUID + "@example.com:443?security=reality", "no public key"},
+		{"unknown transport", "vless://" + testUUID + "@example.com:443?type=quic", "unsupported transport"},
+		{"unknown security", "trojan://secret@example.com:443?security=xtls", "unsupported security"},
+		{"vmess bad base64", "vmess://!!!", "invalid vmess link"},
+		{"vmess bad json", "vmess://" + b64("not json"), "invalid vmess JSON"},
+		{"vmess bad port", "vmess://" + b64(`{"add": "example.com", "port": "0", "id": "x"}`), "invalid vmess port"},
+		{"vmess missing id", "vmess://" + b64(`{"add": "example.com", "port": "443"}`), "missing address or id"},
+		{"ss user info without colon", "ss://" + b64("aes-256-gcm") + "@example.com:8388", "method:password"},
+		{"ss plugin", "ss://" + b64("aes-256-gcm:secret") + "@example.com:8388?plugin=obfs-local", "plugins are not supported"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, _, err := ConvertShareLink(tt.link, 10808)
+			if err == nil {
+				t.Fatalf("ConvertShareLink succeeded:\n%s", config)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("error = %q, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
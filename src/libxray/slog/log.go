@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"sync"
 
+	"segment/logstream"
+
 	alog "github.com/GFW-knocker/Xray-core/app/log"
 	"github.com/GFW-knocker/Xray-core/common"
 	"github.com/GFW-knocker/Xray-core/common/log"
@@ -39,6 +41,30 @@ func WriteLogToBuffer(msg string) {
 	logBuffer.WriteString(msg + "\n")
 }
 
+// WriteLog buffers the message for FetchLogs and publishes it to the log stream.
+func WriteLog(msg log.Message, message string) {
+	WriteLogToBuffer(message)
+	logstream.Publish("xray", levelOf(msg), message)
+}
+
+// levelOf maps an Xray message severity onto the slog level names.
+func levelOf(msg log.Message) string {
+	m, ok := msg.(*log.GeneralMessage)
+	if !ok {
+		return "INFO"
+	}
+	switch m.Severity {
+	case log.Severity_Error:
+		return "ERROR"
+	case log.Severity_Warning:
+		return "WARN"
+	case log.Severity_Debug:
+		return "DEBUG"
+	default:
+		return "INFO"
+	}
+}
+
 // StartLogger sets up the platform-specific log handler once.
 func StartLogger() {
 	logMutex.Lock()
@@ -42,7 +42,7 @@ func (a *androidLogger) Handle(msg log.Message) {
 		message = msg.String()
 	}
 
-	WriteLogToBuffer(message) // save to memory
+	WriteLog(msg, message) // save to memory
 
 	cmsg := C.CString(message)
 	defer C.free(unsafe.Pointer(cmsg))
@@ -17,7 +17,7 @@ func (l *defaultLogger) Handle(msg log.Message) {
 	default:
 		message = msg.String()
 	}
-	WriteLogToBuffer(message)
+	WriteLog(msg, message)
 }
 
 // registerPlatformLogger returns a basic handler for non-Android platforms
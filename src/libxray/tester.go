@@ -0,0 +1,79 @@
+package libxray
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"segment/latency"
+
+	alog "github.com/GFW-knocker/Xray-core/app/log"
+	xraynet "github.com/GFW-knocker/Xray-core/common/net"
+	"github.com/GFW-knocker/Xray-core/common/serial"
+	"github.com/GFW-knocker/Xray-core/core"
+	jsonserial "github.com/GFW-knocker/Xray-core/infra/conf/serial"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// NewTestDialer starts an ephemeral instance without inbounds from config,
+// which is either Xray JSON or a share link. The returned dialer goes through
+// the config's default outbound; close releases the instance.
+func NewTestDialer(config string) (latency.DialFunc, func() error, error) {
+	if !strings.HasPrefix(strings.TrimSpace(config), "{") {
+		converted, _, err := ConvertShareLink(config, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		// Always go through the proxy, even for private test targets.
+		if config, err = sjson.Delete(converted, "routing"); err != nil {
+			return nil, nil, fmt.Errorf("failed: unable to strip routing: %v", err)
+		}
+	}
+	if !gjson.Valid(config) {
+		return nil, nil, fmt.Errorf("failed: invalid JSON config provided")
+	}
+
+	// Nothing may listen: the instance is only reached through core.Dial.
+	for _, key := range []string{"inbounds", "api", "stats", "metrics"} {
+		var err error
+		if config, err = sjson.Delete(config, key); err != nil {
+			return nil, nil, fmt.Errorf("failed: unable to strip %s: %v", key, err)
+		}
+	}
+
+	jsonConfig, err := jsonserial.LoadJSONConfig(strings.NewReader(config))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed: unable to parse JSON config: %v", err)
+	}
+
+	// The log app swaps the process-wide log handler on start, which
+	// belongs to the running core.
+	logType := serial.GetMessageType(&alog.Config{})
+	apps := jsonConfig.App[:0]
+	for _, app := range jsonConfig.App {
+		if app.Type != logType {
+			apps = append(apps, app)
+		}
+	}
+	jsonConfig.App = apps
+
+	instance, err := core.New(jsonConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed: unable to create Xray instance: %v", err)
+	}
+	if err := instance.Start(); err != nil {
+		_ = instance.Close()
+		return nil, nil, fmt.Errorf("failed: unable to start Xray instance: %v", err)
+	}
+
+	dial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dest, err := xraynet.ParseDestination(fmt.Sprintf("%s:%s", network, addr))
+		if err != nil {
+			return nil, fmt.Errorf("failed: unable to parse destination: %v", err)
+		}
+		return core.Dial(ctx, instance, dest)
+	}
+	return dial, instance.Close, nil
+}
@@ -7,6 +7,8 @@ import (
 	"runtime/debug"
 	"time"
 
+	"segment/global"
+
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
 )
@@ -76,9 +78,11 @@ func FreeOSMemory(ctx context.Context) error {
 	return nil
 }
 
-// replaceInboundSocksPort modifies the port for the inbound socks configuration in the provided JSON string.
+// patchInboundSocks sets the port for the first inbound socks configuration in the provided JSON string and,
+// when auth is given, replaces the accounts of every socks, mixed and http inbound so only those
+// credentials are accepted. No local proxy inbound is left open.
 // It uses gjson for parsing and sjson for modification, which is efficient for large JSONs.
-func replaceInboundSocksPort(config string, port int32) (string, error) {
+func patchInboundSocks(config string, port int32, auth *global.ProxyAuth) (string, error) {
 	// First, validate the input config is valid JSON.
 	// gjson.Valid will quickly check if the string is valid JSON without full parsing.
 	if !gjson.Valid(config) {
@@ -92,47 +96,71 @@ func replaceInboundSocksPort(config string, port int32) (string, error) {
 	}
 
 	modifiedConfig := config // Start with the original config
+	patched := false
+	var err error
 
 	// Iterate through the inbounds array using gjson's ForEach
 	inboundsResult.ForEach(func(key, value gjson.Result) bool {
 		// key here would be the array index (e.g., "0", "1", etc.)
 		protocol := value.Get("protocol").String()
 
-		if protocol == "socks" {
-			// Construct the path to the 'port' field for this specific inbound object.
+		if protocol == "socks" && !patched {
+			// Only the first socks inbound is moved to port, it is the one the app and tun2socks dial.
 			// Example path: "inbounds.0.port", "inbounds.1.port"
 			portPath := fmt.Sprintf("inbounds.%s.port", key.String())
+			if modifiedConfig, err = sjson.Set(modifiedConfig, portPath, port); err != nil {
+				return false // Stop iteration
+			}
+			patched = true
+		}
 
-			// Use sjson to set the new port value.
-			// sjson.Set handles creating new JSON if the path doesn't exist,
-			// but here we know 'inbounds' and 'port' should exist for a socks inbound.
-			var err error
-			modifiedConfig, err = sjson.Set(modifiedConfig, portPath, port)
-			if err != nil {
-				// If sjson.Set fails for some reason, return the error immediately.
-				// This might indicate an invalid path or internal sjson error.
-				modifiedConfig = "" // Clear to indicate failure
-				return false        // Stop iteration
+		if auth == nil {
+			return true
+		}
+		settingsPath := fmt.Sprintf("inbounds.%s.settings", key.String())
+		switch protocol {
+		case "socks", "mixed":
+			if modifiedConfig, err = sjson.Set(modifiedConfig, settingsPath+".auth", "password"); err != nil {
+				return false
 			}
-			// Found and modified, so we can stop iterating.
-			return false // Returning false stops the ForEach loop
+		case "http":
+		default:
+			return true // Continue to the next element in the array
 		}
-		return true // Continue to the next element in the array
+		modifiedConfig, err = sjson.Set(modifiedConfig, settingsPath+".accounts", []map[string]string{
+			{"user": auth.Username, "pass": auth.Password},
+		})
+		return err == nil
 	})
 
-	if modifiedConfig == "" {
-		// This condition would be hit if sjson.Set returned an error within the loop.
-		return "", fmt.Errorf("failed: error during JSON modification to set socks port")
+	if err != nil {
+		// sjson.Set failed within the loop, which indicates an invalid path or internal sjson error.
+		return "", fmt.Errorf("failed: error during JSON modification to set socks port and credentials: %v", err)
+	}
+	if !patched && auth != nil {
+		// The credentials would be dropped and the proxy left open.
+		return "", fmt.Errorf("failed: proxy auth is set but the config has no socks inbound to apply it to")
 	}
 
-	// Optionally, re-indent the JSON for readability if desired.
-	// sjson doesn't automatically re-indent the whole document.
-	// If pretty-printing is required, you would need to parse with encoding/json
-	// and marshal with Indent, or use a gjson/sjson based pretty-printer if available.
-	// For most use cases, especially if passed around internally, compactness is better.
-	// If pretty-printing is critical, you could do:
-	// parsed, _ := gjson.Parse(modifiedConfig).Value().(map[string]interface{})
-	// indented, err := json.MarshalIndent(parsed, "", "  ")
-	// return string(indented), err
 	return modifiedConfig, nil
 }
+
+// enableTrafficStats turns on the stats manager and the outbound traffic counters
+// so the running instance can report its data usage. Existing settings are kept.
+func enableTrafficStats(config string) (string, error) {
+	var err error
+	if !gjson.Get(config, "stats").Exists() {
+		if config, err = sjson.SetRaw(config, "stats", "{}"); err != nil {
+			return "", fmt.Errorf("failed: unable to enable stats: %v", err)
+		}
+	}
+	for _, path := range []string{
+		"policy.system.statsOutboundUplink",
+		"policy.system.statsOutboundDownlink",
+	} {
+		if config, err = sjson.Set(config, path, true); err != nil {
+			return "", fmt.Errorf("failed: unable to set %s: %v", path, err)
+		}
+	}
+	return config, nil
+}
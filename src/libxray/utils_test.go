@@ -0,0 +1,54 @@
+package libxray
+
+import (
+	"strings"
+	"testing"
+
+	"segment/global"
+
+	"github.com/tidwall/gjson"
+)
+
+func TestPatchInboundSocks(t *testing.T) {
+	const config = `{"inbounds": [
+		{"tag": "socks-in", "protocol": "socks", "port": 1080, "settings": {"auth": "noauth"}},
+		{"tag": "socks-lan", "protocol": "socks", "port": 1081, "settings": {"auth": "noauth"}},
+		{"tag": "http-in", "protocol": "http", "port": 8080},
+		{"tag": "mixed-in", "protocol": "mixed", "port": 2080, "settings": {}},
+		{"tag": "dns-in", "protocol": "dokodemo-door", "port": 5353}
+	]}`
+	auth := &global.ProxyAuth{Username: "user", Password: "pass"}
+
+	patched, err := patchInboundSocks(config, 10808, auth)
+	if err != nil {
+		t.Fatalf("patchInboundSocks: %v", err)
+	}
+
+	inbounds := gjson.Get(patched, "inbounds").Array()
+	ports := []int64{10808, 1081, 8080, 2080, 5353}
+	for i, in := range inbounds {
+		if got := in.Get("port").Int(); got != ports[i] {
+			t.Errorf("%s port = %d, want %d", in.Get("tag"), got, ports[i])
+		}
+	}
+	for _, in := range inbounds[:4] {
+		accounts := in.Get("settings.accounts").Array()
+		if len(accounts) != 1 || accounts[0].Get("user").String() != "user" || accounts[0].Get("pass").String() != "pass" {
+			t.Errorf("%s accounts = %s, want the given credentials", in.Get("tag"), in.Get("settings.accounts").Raw)
+		}
+		if in.Get("protocol").String() != "http" && in.Get("settings.auth").String() != "password" {
+			t.Errorf("%s auth = %q, want password", in.Get("tag"), in.Get("settings.auth").String())
+		}
+	}
+	if inbounds[4].Get("settings").Exists() {
+		t.Errorf("dokodemo-door inbound was changed: %s", inbounds[4].Raw)
+	}
+
+	if _, err := patchInboundSocks(`{"inbounds": [{"protocol": "http", "port": 8080}]}`, 10808, auth); err == nil ||
+		!strings.Contains(err.Error(), "no socks inbound") {
+		t.Fatalf("error = %v, want a missing socks inbound error", err)
+	}
+	if _, err := patchInboundSocks(`{"inbounds": [{"protocol": "http", "port": 8080}]}`, 10808, nil); err != nil {
+		t.Fatalf("without auth: %v", err)
+	}
+}
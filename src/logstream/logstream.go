@@ -0,0 +1,107 @@
+package logstream
+
+import (
+	"sync"
+	"time"
+)
+
+// Entry is a single structured log line produced by one of the cores.
+type Entry struct {
+	Seq     uint64
+	Time    time.Time
+	Level   string
+	Core    string
+	Message string
+}
+
+// Hub keeps a bounded backlog of log entries and lets any number of readers
+// follow it, each with its own cursor. Reading never consumes entries.
+type Hub struct {
+	mu      sync.Mutex
+	entries []Entry       // ring buffer indexed by Seq % len(entries)
+	next    uint64        // sequence number of the next published entry
+	notify  chan struct{} // closed and replaced on every publish
+}
+
+const defaultBacklog = 4096
+
+var defaultHub = NewHub(defaultBacklog)
+
+// NewHub creates a hub retaining at most size entries.
+func NewHub(size int) *Hub {
+	if size <= 0 {
+		size = defaultBacklog
+	}
+	return &Hub{
+		entries: make([]Entry, size),
+		notify:  make(chan struct{}),
+	}
+}
+
+// Publish appends an entry and wakes up every waiting reader.
+func (h *Hub) Publish(core, level, message string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.entries[h.next%uint64(len(h.entries))] = Entry{
+		Seq:     h.next,
+		Time:    time.Now(),
+		Level:   level,
+		Core:    core,
+		Message: message,
+	}
+	h.next++
+
+	close(h.notify)
+	h.notify = make(chan struct{})
+}
+
+// Since returns the retained entries starting at cursor, the cursor to use
+// for the next call and a channel that is closed once more entries arrive.
+// Entries that already fell out of the backlog are skipped.
+func (h *Hub) Since(cursor uint64) ([]Entry, uint64, <-chan struct{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if oldest := h.oldest(); cursor < oldest {
+		cursor = oldest
+	}
+	if cursor >= h.next {
+		return nil, h.next, h.notify
+	}
+
+	out := make([]Entry, 0, h.next-cursor)
+	for seq := cursor; seq < h.next; seq++ {
+		out = append(out, h.entries[seq%uint64(len(h.entries))])
+	}
+	return out, h.next, h.notify
+}
+
+// Head returns the cursor pointing just past the newest entry.
+func (h *Hub) Head() uint64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.next
+}
+
+func (h *Hub) oldest() uint64 {
+	if size := uint64(len(h.entries)); h.next > size {
+		return h.next - size
+	}
+	return 0
+}
+
+// Publish appends an entry to the default hub.
+func Publish(core, level, message string) {
+	defaultHub.Publish(core, level, message)
+}
+
+// Since reads from the default hub, see Hub.Since.
+func Since(cursor uint64) ([]Entry, uint64, <-chan struct{}) {
+	return defaultHub.Since(cursor)
+}
+
+// Head returns the head cursor of the default hub.
+func Head() uint64 {
+	return defaultHub.Head()
+}
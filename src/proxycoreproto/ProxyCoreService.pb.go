@@ -21,6 +21,122 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PingErrorCategory int32
+
+const (
+	PingErrorCategory_PING_ERROR_NONE       PingErrorCategory = 0
+	PingErrorCategory_PING_ERROR_TIMEOUT    PingErrorCategory = 1
+	PingErrorCategory_PING_ERROR_REFUSED    PingErrorCategory = 2
+	PingErrorCategory_PING_ERROR_TLS        PingErrorCategory = 3
+	PingErrorCategory_PING_ERROR_BAD_STATUS PingErrorCategory = 4
+	PingErrorCategory_PING_ERROR_DNS        PingErrorCategory = 5
+	PingErrorCategory_PING_ERROR_OTHER      PingErrorCategory = 6
+)
+
+// Enum value maps for PingErrorCategory.
+var (
+	PingErrorCategory_name = map[int32]string{
+		0: "PING_ERROR_NONE",
+		1: "PING_ERROR_TIMEOUT",
+		2: "PING_ERROR_REFUSED",
+		3: "PING_ERROR_TLS",
+		4: "PING_ERROR_BAD_STATUS",
+		5: "PING_ERROR_DNS",
+		6: "PING_ERROR_OTHER",
+	}
+	PingErrorCategory_value = map[string]int32{
+		"PING_ERROR_NONE":       0,
+		"PING_ERROR_TIMEOUT":    1,
+		"PING_ERROR_REFUSED":    2,
+		"PING_ERROR_TLS":        3,
+		"PING_ERROR_BAD_STATUS": 4,
+		"PING_ERROR_DNS":        5,
+		"PING_ERROR_OTHER":      6,
+	}
+)
+
+func (x PingErrorCategory) Enum() *PingErrorCategory {
+	p := new(PingErrorCategory)
+	*p = x
+	return p
+}
+
+func (x PingErrorCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PingErrorCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_ProxyCoreService_proto_enumTypes[0].Descriptor()
+}
+
+func (PingErrorCategory) Type() protoreflect.EnumType {
+	return &file_proto_ProxyCoreService_proto_enumTypes[0]
+}
+
+func (x PingErrorCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PingErrorCategory.Descriptor instead.
+func (PingErrorCategory) EnumDescriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{0}
+}
+
+type CoreState int32
+
+const (
+	CoreState_CORE_STATE_STOPPED  CoreState = 0
+	CoreState_CORE_STATE_STARTING CoreState = 1
+	CoreState_CORE_STATE_RUNNING  CoreState = 2
+	CoreState_CORE_STATE_STOPPING CoreState = 3
+	CoreState_CORE_STATE_FAILED   CoreState = 4
+)
+
+// Enum value maps for CoreState.
+var (
+	CoreState_name = map[int32]string{
+		0: "CORE_STATE_STOPPED",
+		1: "CORE_STATE_STARTING",
+		2: "CORE_STATE_RUNNING",
+		3: "CORE_STATE_STOPPING",
+		4: "CORE_STATE_FAILED",
+	}
+	CoreState_value = map[string]int32{
+		"CORE_STATE_STOPPED":  0,
+		"CORE_STATE_STARTING": 1,
+		"CORE_STATE_RUNNING":  2,
+		"CORE_STATE_STOPPING": 3,
+		"CORE_STATE_FAILED":   4,
+	}
+)
+
+func (x CoreState) Enum() *CoreState {
+	p := new(CoreState)
+	*p = x
+	return p
+}
+
+func (x CoreState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CoreState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_ProxyCoreService_proto_enumTypes[1].Descriptor()
+}
+
+func (CoreState) Type() protoreflect.EnumType {
+	return &file_proto_ProxyCoreService_proto_enumTypes[1]
+}
+
+func (x CoreState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CoreState.Descriptor instead.
+func (CoreState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{1}
+}
+
 type StartCoreRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CoreName      string                 `protobuf:"bytes,1,opt,name=coreName,proto3" json:"coreName,omitempty"`
@@ -31,6 +147,11 @@ type StartCoreRequest struct {
 	IsVpnMode     bool                   `protobuf:"varint,6,opt,name=isVpnMode,proto3" json:"isVpnMode,omitempty"`
 	TunFD         uint32                 `protobuf:"varint,7,opt,name=tunFD,proto3" json:"tunFD,omitempty"`
 	ProxyPort     int32                  `protobuf:"varint,8,opt,name=proxyPort,proto3" json:"proxyPort,omitempty"`
+	Pool          *PoolOptions           `protobuf:"bytes,9,opt,name=pool,proto3" json:"pool,omitempty"`           // When set, config is ignored and the core balances over pool.servers
+	HttpPort      int32                  `protobuf:"varint,10,opt,name=httpPort,proto3" json:"httpPort,omitempty"` // Extra HTTP proxy port, 0 for none (outline)
+	Mixed         bool                   `protobuf:"varint,11,opt,name=mixed,proto3" json:"mixed,omitempty"`       // proxyPort also takes HTTP proxy clients (outline)
+	Auth          *ProxyAuth             `protobuf:"bytes,12,opt,name=auth,proto3" json:"auth,omitempty"`          // Credentials the local inbounds require
+	Tun           *TunOptions            `protobuf:"bytes,13,opt,name=tun,proto3" json:"tun,omitempty"`            // tun2socks tuning in VPN mode, defaults when unset
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -121,27 +242,71 @@ func (x *StartCoreRequest) GetProxyPort() int32 {
 	return 0
 }
 
-type MeasurePingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           []string               `protobuf:"bytes,1,rep,name=url,proto3" json:"url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StartCoreRequest) GetPool() *PoolOptions {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
 }
 
-func (x *MeasurePingRequest) Reset() {
-	*x = MeasurePingRequest{}
+func (x *StartCoreRequest) GetHttpPort() int32 {
+	if x != nil {
+		return x.HttpPort
+	}
+	return 0
+}
+
+func (x *StartCoreRequest) GetMixed() bool {
+	if x != nil {
+		return x.Mixed
+	}
+	return false
+}
+
+func (x *StartCoreRequest) GetAuth() *ProxyAuth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *StartCoreRequest) GetTun() *TunOptions {
+	if x != nil {
+		return x.Tun
+	}
+	return nil
+}
+
+type TunOptions struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Mtu                      int32                  `protobuf:"varint,1,opt,name=mtu,proto3" json:"mtu,omitempty"`                     // 1500 when 0
+	UdpTimeoutSec            int32                  `protobuf:"varint,2,opt,name=udpTimeoutSec,proto3" json:"udpTimeoutSec,omitempty"` // Engine default when 0
+	TcpSendBufferBytes       int32                  `protobuf:"varint,3,opt,name=tcpSendBufferBytes,proto3" json:"tcpSendBufferBytes,omitempty"`
+	TcpReceiveBufferBytes    int32                  `protobuf:"varint,4,opt,name=tcpReceiveBufferBytes,proto3" json:"tcpReceiveBufferBytes,omitempty"`
+	TcpModerateReceiveBuffer bool                   `protobuf:"varint,5,opt,name=tcpModerateReceiveBuffer,proto3" json:"tcpModerateReceiveBuffer,omitempty"` // TCP receive buffer auto-tuning
+	LogLevel                 string                 `protobuf:"bytes,6,opt,name=logLevel,proto3" json:"logLevel,omitempty"`                                  // debug, info (default), warning, error or silent
+	RestApi                  string                 `protobuf:"bytes,7,opt,name=restApi,proto3" json:"restApi,omitempty"`                                    // host:port of the tun2socks REST API
+	MulticastGroups          []string               `protobuf:"bytes,8,rep,name=multicastGroups,proto3" json:"multicastGroups,omitempty"`
+	Dns                      *TunDnsOptions         `protobuf:"bytes,9,opt,name=dns,proto3" json:"dns,omitempty"`        // DNS from the TUN device, through the proxy when unset
+	Bypass                   *TunBypassOptions      `protobuf:"bytes,10,opt,name=bypass,proto3" json:"bypass,omitempty"` // Destinations dialed directly
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *TunOptions) Reset() {
+	*x = TunOptions{}
 	mi := &file_proto_ProxyCoreService_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MeasurePingRequest) String() string {
+func (x *TunOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MeasurePingRequest) ProtoMessage() {}
+func (*TunOptions) ProtoMessage() {}
 
-func (x *MeasurePingRequest) ProtoReflect() protoreflect.Message {
+func (x *TunOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_ProxyCoreService_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -153,39 +318,106 @@ func (x *MeasurePingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MeasurePingRequest.ProtoReflect.Descriptor instead.
-func (*MeasurePingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TunOptions.ProtoReflect.Descriptor instead.
+func (*TunOptions) Descriptor() ([]byte, []int) {
 	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *MeasurePingRequest) GetUrl() []string {
+func (x *TunOptions) GetMtu() int32 {
 	if x != nil {
-		return x.Url
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *TunOptions) GetUdpTimeoutSec() int32 {
+	if x != nil {
+		return x.UdpTimeoutSec
+	}
+	return 0
+}
+
+func (x *TunOptions) GetTcpSendBufferBytes() int32 {
+	if x != nil {
+		return x.TcpSendBufferBytes
+	}
+	return 0
+}
+
+func (x *TunOptions) GetTcpReceiveBufferBytes() int32 {
+	if x != nil {
+		return x.TcpReceiveBufferBytes
+	}
+	return 0
+}
+
+func (x *TunOptions) GetTcpModerateReceiveBuffer() bool {
+	if x != nil {
+		return x.TcpModerateReceiveBuffer
+	}
+	return false
+}
+
+func (x *TunOptions) GetLogLevel() string {
+	if x != nil {
+		return x.LogLevel
+	}
+	return ""
+}
+
+func (x *TunOptions) GetRestApi() string {
+	if x != nil {
+		return x.RestApi
+	}
+	return ""
+}
+
+func (x *TunOptions) GetMulticastGroups() []string {
+	if x != nil {
+		return x.MulticastGroups
 	}
 	return nil
 }
 
-type BooleanResponse struct {
+func (x *TunOptions) GetDns() *TunDnsOptions {
+	if x != nil {
+		return x.Dns
+	}
+	return nil
+}
+
+func (x *TunOptions) GetBypass() *TunBypassOptions {
+	if x != nil {
+		return x.Bypass
+	}
+	return nil
+}
+
+// Queries to port 53 over UDP and TCP are answered through the upstreams.
+// Fake IPs are kept across tunnel restarts while the range is unchanged.
+type TunDnsOptions struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       bool                   `protobuf:"varint,1,opt,name=message,proto3" json:"message,omitempty"`
+	Upstreams     []string               `protobuf:"bytes,1,rep,name=upstreams,proto3" json:"upstreams,omitempty"`     // https:// (DoH) or tls:// (DoT) URLs dialed through the core
+	FakeIp        bool                   `protobuf:"varint,2,opt,name=fakeIp,proto3" json:"fakeIp,omitempty"`          // Answer A queries with fake IPs mapped back to domains
+	FakeIpRange   string                 `protobuf:"bytes,3,opt,name=fakeIpRange,proto3" json:"fakeIpRange,omitempty"` // 198.18.0.0/15 when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BooleanResponse) Reset() {
-	*x = BooleanResponse{}
+func (x *TunDnsOptions) Reset() {
+	*x = TunDnsOptions{}
 	mi := &file_proto_ProxyCoreService_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BooleanResponse) String() string {
+func (x *TunDnsOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BooleanResponse) ProtoMessage() {}
+func (*TunDnsOptions) ProtoMessage() {}
 
-func (x *BooleanResponse) ProtoReflect() protoreflect.Message {
+func (x *TunDnsOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_ProxyCoreService_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -197,39 +429,56 @@ func (x *BooleanResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BooleanResponse.ProtoReflect.Descriptor instead.
-func (*BooleanResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TunDnsOptions.ProtoReflect.Descriptor instead.
+func (*TunDnsOptions) Descriptor() ([]byte, []int) {
 	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *BooleanResponse) GetMessage() bool {
+func (x *TunDnsOptions) GetUpstreams() []string {
 	if x != nil {
-		return x.Message
+		return x.Upstreams
+	}
+	return nil
+}
+
+func (x *TunDnsOptions) GetFakeIp() bool {
+	if x != nil {
+		return x.FakeIp
 	}
 	return false
 }
 
-type VersionResponse struct {
+func (x *TunDnsOptions) GetFakeIpRange() string {
+	if x != nil {
+		return x.FakeIpRange
+	}
+	return ""
+}
+
+type TunBypassOptions struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Private       bool                   `protobuf:"varint,1,opt,name=private,proto3" json:"private,omitempty"` // Private, loopback and link-local ranges
+	Cidrs         []string               `protobuf:"bytes,2,rep,name=cidrs,proto3" json:"cidrs,omitempty"`
+	Domains       []string               `protobuf:"bytes,3,rep,name=domains,proto3" json:"domains,omitempty"` // Domains and their subdomains, needs fakeIp
+	Geoip         []string               `protobuf:"bytes,4,rep,name=geoip,proto3" json:"geoip,omitempty"`     // Country codes from geoip.dat in dir
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VersionResponse) Reset() {
-	*x = VersionResponse{}
+func (x *TunBypassOptions) Reset() {
+	*x = TunBypassOptions{}
 	mi := &file_proto_ProxyCoreService_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VersionResponse) String() string {
+func (x *TunBypassOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionResponse) ProtoMessage() {}
+func (*TunBypassOptions) ProtoMessage() {}
 
-func (x *VersionResponse) ProtoReflect() protoreflect.Message {
+func (x *TunBypassOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_ProxyCoreService_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -241,39 +490,61 @@ func (x *VersionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
-func (*VersionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TunBypassOptions.ProtoReflect.Descriptor instead.
+func (*TunBypassOptions) Descriptor() ([]byte, []int) {
 	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *VersionResponse) GetMessage() string {
+func (x *TunBypassOptions) GetPrivate() bool {
 	if x != nil {
-		return x.Message
+		return x.Private
 	}
-	return ""
+	return false
 }
 
-type LogResponse struct {
+func (x *TunBypassOptions) GetCidrs() []string {
+	if x != nil {
+		return x.Cidrs
+	}
+	return nil
+}
+
+func (x *TunBypassOptions) GetDomains() []string {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+func (x *TunBypassOptions) GetGeoip() []string {
+	if x != nil {
+		return x.Geoip
+	}
+	return nil
+}
+
+type ProxyAuth struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Logs          string                 `protobuf:"bytes,1,opt,name=logs,proto3" json:"logs,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogResponse) Reset() {
-	*x = LogResponse{}
+func (x *ProxyAuth) Reset() {
+	*x = ProxyAuth{}
 	mi := &file_proto_ProxyCoreService_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogResponse) String() string {
+func (x *ProxyAuth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogResponse) ProtoMessage() {}
+func (*ProxyAuth) ProtoMessage() {}
 
-func (x *LogResponse) ProtoReflect() protoreflect.Message {
+func (x *ProxyAuth) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_ProxyCoreService_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -285,39 +556,49 @@ func (x *LogResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogResponse.ProtoReflect.Descriptor instead.
-func (*LogResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProxyAuth.ProtoReflect.Descriptor instead.
+func (*ProxyAuth) Descriptor() ([]byte, []int) {
 	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *LogResponse) GetLogs() string {
+func (x *ProxyAuth) GetUsername() string {
 	if x != nil {
-		return x.Logs
+		return x.Username
 	}
 	return ""
 }
 
-type MeasurePingResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*PingResult          `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ProxyAuth) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
 }
 
-func (x *MeasurePingResponse) Reset() {
-	*x = MeasurePingResponse{}
+type PoolOptions struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Servers          []string               `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`   // Share links or outbound JSON for xray
+	Strategy         string                 `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"` // leastPing (default), leastLoad, roundRobin or random; outline runs leastLoad as leastPing and random as roundRobin
+	ProbeUrl         string                 `protobuf:"bytes,3,opt,name=probeUrl,proto3" json:"probeUrl,omitempty"`
+	ProbeIntervalSec int32                  `protobuf:"varint,4,opt,name=probeIntervalSec,proto3" json:"probeIntervalSec,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PoolOptions) Reset() {
+	*x = PoolOptions{}
 	mi := &file_proto_ProxyCoreService_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MeasurePingResponse) String() string {
+func (x *PoolOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MeasurePingResponse) ProtoMessage() {}
+func (*PoolOptions) ProtoMessage() {}
 
-func (x *MeasurePingResponse) ProtoReflect() protoreflect.Message {
+func (x *PoolOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_ProxyCoreService_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -329,40 +610,60 @@ func (x *MeasurePingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MeasurePingResponse.ProtoReflect.Descriptor instead.
-func (*MeasurePingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PoolOptions.ProtoReflect.Descriptor instead.
+func (*PoolOptions) Descriptor() ([]byte, []int) {
 	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *MeasurePingResponse) GetResults() []*PingResult {
+func (x *PoolOptions) GetServers() []string {
 	if x != nil {
-		return x.Results
+		return x.Servers
 	}
 	return nil
 }
 
-type PingResult struct {
+func (x *PoolOptions) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *PoolOptions) GetProbeUrl() string {
+	if x != nil {
+		return x.ProbeUrl
+	}
+	return ""
+}
+
+func (x *PoolOptions) GetProbeIntervalSec() int32 {
+	if x != nil {
+		return x.ProbeIntervalSec
+	}
+	return 0
+}
+
+type MeasurePingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Delay         int64                  `protobuf:"varint,2,opt,name=delay,proto3" json:"delay,omitempty"`
+	Url           []string               `protobuf:"bytes,1,rep,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PingResult) Reset() {
-	*x = PingResult{}
+func (x *MeasurePingRequest) Reset() {
+	*x = MeasurePingRequest{}
 	mi := &file_proto_ProxyCoreService_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PingResult) String() string {
+func (x *MeasurePingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingResult) ProtoMessage() {}
+func (*MeasurePingRequest) ProtoMessage() {}
 
-func (x *PingResult) ProtoReflect() protoreflect.Message {
+func (x *MeasurePingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_ProxyCoreService_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -374,34 +675,1703 @@ func (x *PingResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingResult.ProtoReflect.Descriptor instead.
-func (*PingResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use MeasurePingRequest.ProtoReflect.Descriptor instead.
+func (*MeasurePingRequest) Descriptor() ([]byte, []int) {
 	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PingResult) GetUrl() string {
+func (x *MeasurePingRequest) GetUrl() []string {
 	if x != nil {
 		return x.Url
 	}
-	return ""
+	return nil
 }
 
-func (x *PingResult) GetDelay() int64 {
-	if x != nil {
-		return x.Delay
-	}
-	return 0
+type StreamLogsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IncludeBacklog bool                   `protobuf:"varint,1,opt,name=includeBacklog,proto3" json:"includeBacklog,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-type Empty struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StreamLogsRequest) Reset() {
+	*x = StreamLogsRequest{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLogsRequest) ProtoMessage() {}
+
+func (x *StreamLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StreamLogsRequest) GetIncludeBacklog() bool {
+	if x != nil {
+		return x.IncludeBacklog
+	}
+	return false
+}
+
+type TrafficStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResetCounters bool                   `protobuf:"varint,1,opt,name=resetCounters,proto3" json:"resetCounters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrafficStatsRequest) Reset() {
+	*x = TrafficStatsRequest{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrafficStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficStatsRequest) ProtoMessage() {}
+
+func (x *TrafficStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficStatsRequest.ProtoReflect.Descriptor instead.
+func (*TrafficStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TrafficStatsRequest) GetResetCounters() bool {
+	if x != nil {
+		return x.ResetCounters
+	}
+	return false
+}
+
+type CloseConnectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseConnectionRequest) Reset() {
+	*x = CloseConnectionRequest{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseConnectionRequest) ProtoMessage() {}
+
+func (x *CloseConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseConnectionRequest.ProtoReflect.Descriptor instead.
+func (*CloseConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CloseConnectionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ConvertShareLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          string                 `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	ProxyPort     int32                  `protobuf:"varint,2,opt,name=proxyPort,proto3" json:"proxyPort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertShareLinkRequest) Reset() {
+	*x = ConvertShareLinkRequest{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertShareLinkRequest) ProtoMessage() {}
+
+func (x *ConvertShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*ConvertShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ConvertShareLinkRequest) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *ConvertShareLinkRequest) GetProxyPort() int32 {
+	if x != nil {
+		return x.ProxyPort
+	}
+	return 0
+}
+
+type FetchSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	ViaCore       bool                   `protobuf:"varint,2,opt,name=viaCore,proto3" json:"viaCore,omitempty"`     // Fetch through the running core instead of directly
+	UserAgent     string                 `protobuf:"bytes,3,opt,name=userAgent,proto3" json:"userAgent,omitempty"`  // Optional, defaults to a v2rayNG agent
+	TimeoutMs     int32                  `protobuf:"varint,4,opt,name=timeoutMs,proto3" json:"timeoutMs,omitempty"` // Optional, defaults to 15000
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchSubscriptionRequest) Reset() {
+	*x = FetchSubscriptionRequest{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchSubscriptionRequest) ProtoMessage() {}
+
+func (x *FetchSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*FetchSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FetchSubscriptionRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *FetchSubscriptionRequest) GetViaCore() bool {
+	if x != nil {
+		return x.ViaCore
+	}
+	return false
+}
+
+func (x *FetchSubscriptionRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *FetchSubscriptionRequest) GetTimeoutMs() int32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type TestConfigsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Configs       []*TestConfig          `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                  // Optional, defaults to generate_204
+	Concurrency   int32                  `protobuf:"varint,3,opt,name=concurrency,proto3" json:"concurrency,omitempty"` // Optional, defaults to 8
+	TimeoutMs     int32                  `protobuf:"varint,4,opt,name=timeoutMs,proto3" json:"timeoutMs,omitempty"`     // Per config, optional, defaults to 10000
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestConfigsRequest) Reset() {
+	*x = TestConfigsRequest{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestConfigsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestConfigsRequest) ProtoMessage() {}
+
+func (x *TestConfigsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestConfigsRequest.ProtoReflect.Descriptor instead.
+func (*TestConfigsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TestConfigsRequest) GetConfigs() []*TestConfig {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+func (x *TestConfigsRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *TestConfigsRequest) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *TestConfigsRequest) GetTimeoutMs() int32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type TestConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`             // Echoed back in the result
+	CoreName      string                 `protobuf:"bytes,2,opt,name=coreName,proto3" json:"coreName,omitempty"` // Optional, detected from config when empty
+	Config        string                 `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`     // Xray JSON, share link or Outline SSConfig JSON
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestConfig) Reset() {
+	*x = TestConfig{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestConfig) ProtoMessage() {}
+
+func (x *TestConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestConfig.ProtoReflect.Descriptor instead.
+func (*TestConfig) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TestConfig) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TestConfig) GetCoreName() string {
+	if x != nil {
+		return x.CoreName
+	}
+	return ""
+}
+
+func (x *TestConfig) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+type BooleanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       bool                   `protobuf:"varint,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BooleanResponse) Reset() {
+	*x = BooleanResponse{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BooleanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BooleanResponse) ProtoMessage() {}
+
+func (x *BooleanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BooleanResponse.ProtoReflect.Descriptor instead.
+func (*BooleanResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BooleanResponse) GetMessage() bool {
+	if x != nil {
+		return x.Message
+	}
+	return false
+}
+
+type VersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VersionResponse) Reset() {
+	*x = VersionResponse{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionResponse) ProtoMessage() {}
+
+func (x *VersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
+func (*VersionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *VersionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type LogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logs          string                 `protobuf:"bytes,1,opt,name=logs,proto3" json:"logs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogResponse) Reset() {
+	*x = LogResponse{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogResponse) ProtoMessage() {}
+
+func (x *LogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogResponse.ProtoReflect.Descriptor instead.
+func (*LogResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LogResponse) GetLogs() string {
+	if x != nil {
+		return x.Logs
+	}
+	return ""
+}
+
+type MeasurePingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*PingResult          `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeasurePingResponse) Reset() {
+	*x = MeasurePingResponse{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeasurePingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeasurePingResponse) ProtoMessage() {}
+
+func (x *MeasurePingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeasurePingResponse.ProtoReflect.Descriptor instead.
+func (*MeasurePingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MeasurePingResponse) GetResults() []*PingResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type PingResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Url            string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Delay          int64                  `protobuf:"varint,2,opt,name=delay,proto3" json:"delay,omitempty"`       // Whole request in milliseconds, -1 on failure
+	DnsDelay       int64                  `protobuf:"varint,3,opt,name=dnsDelay,proto3" json:"dnsDelay,omitempty"` // Milliseconds, phases that did not run are 0
+	ConnectDelay   int64                  `protobuf:"varint,4,opt,name=connectDelay,proto3" json:"connectDelay,omitempty"`
+	TlsDelay       int64                  `protobuf:"varint,5,opt,name=tlsDelay,proto3" json:"tlsDelay,omitempty"`
+	FirstByteDelay int64                  `protobuf:"varint,6,opt,name=firstByteDelay,proto3" json:"firstByteDelay,omitempty"` // From the start of the request
+	StatusCode     int32                  `protobuf:"varint,7,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
+	ErrorCategory  PingErrorCategory      `protobuf:"varint,8,opt,name=errorCategory,proto3,enum=ProxyCore.PingErrorCategory" json:"errorCategory,omitempty"`
+	Error          string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PingResult) Reset() {
+	*x = PingResult{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResult) ProtoMessage() {}
+
+func (x *PingResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResult.ProtoReflect.Descriptor instead.
+func (*PingResult) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PingResult) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PingResult) GetDelay() int64 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+func (x *PingResult) GetDnsDelay() int64 {
+	if x != nil {
+		return x.DnsDelay
+	}
+	return 0
+}
+
+func (x *PingResult) GetConnectDelay() int64 {
+	if x != nil {
+		return x.ConnectDelay
+	}
+	return 0
+}
+
+func (x *PingResult) GetTlsDelay() int64 {
+	if x != nil {
+		return x.TlsDelay
+	}
+	return 0
+}
+
+func (x *PingResult) GetFirstByteDelay() int64 {
+	if x != nil {
+		return x.FirstByteDelay
+	}
+	return 0
+}
+
+func (x *PingResult) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *PingResult) GetErrorCategory() PingErrorCategory {
+	if x != nil {
+		return x.ErrorCategory
+	}
+	return PingErrorCategory_PING_ERROR_NONE
+}
+
+func (x *PingResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type TrafficStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uplink        int64                  `protobuf:"varint,1,opt,name=uplink,proto3" json:"uplink,omitempty"`
+	Downlink      int64                  `protobuf:"varint,2,opt,name=downlink,proto3" json:"downlink,omitempty"`
+	UplinkRate    int64                  `protobuf:"varint,3,opt,name=uplinkRate,proto3" json:"uplinkRate,omitempty"`
+	DownlinkRate  int64                  `protobuf:"varint,4,opt,name=downlinkRate,proto3" json:"downlinkRate,omitempty"`
+	Connections   int32                  `protobuf:"varint,5,opt,name=connections,proto3" json:"connections,omitempty"` // Live connections of the core
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrafficStatsResponse) Reset() {
+	*x = TrafficStatsResponse{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrafficStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficStatsResponse) ProtoMessage() {}
+
+func (x *TrafficStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficStatsResponse.ProtoReflect.Descriptor instead.
+func (*TrafficStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TrafficStatsResponse) GetUplink() int64 {
+	if x != nil {
+		return x.Uplink
+	}
+	return 0
+}
+
+func (x *TrafficStatsResponse) GetDownlink() int64 {
+	if x != nil {
+		return x.Downlink
+	}
+	return 0
+}
+
+func (x *TrafficStatsResponse) GetUplinkRate() int64 {
+	if x != nil {
+		return x.UplinkRate
+	}
+	return 0
+}
+
+func (x *TrafficStatsResponse) GetDownlinkRate() int64 {
+	if x != nil {
+		return x.DownlinkRate
+	}
+	return 0
+}
+
+func (x *TrafficStatsResponse) GetConnections() int32 {
+	if x != nil {
+		return x.Connections
+	}
+	return 0
+}
+
+type TunStatsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PacketsIn      uint64                 `protobuf:"varint,1,opt,name=packetsIn,proto3" json:"packetsIn,omitempty"` // From the OS into the tunnel
+	PacketsOut     uint64                 `protobuf:"varint,2,opt,name=packetsOut,proto3" json:"packetsOut,omitempty"`
+	BytesIn        uint64                 `protobuf:"varint,3,opt,name=bytesIn,proto3" json:"bytesIn,omitempty"`
+	BytesOut       uint64                 `protobuf:"varint,4,opt,name=bytesOut,proto3" json:"bytesOut,omitempty"`
+	DroppedPackets uint64                 `protobuf:"varint,5,opt,name=droppedPackets,proto3" json:"droppedPackets,omitempty"`
+	TcpSessions    int32                  `protobuf:"varint,6,opt,name=tcpSessions,proto3" json:"tcpSessions,omitempty"` // Currently relayed
+	UdpSessions    int32                  `protobuf:"varint,7,opt,name=udpSessions,proto3" json:"udpSessions,omitempty"`
+	UptimeSec      int64                  `protobuf:"varint,8,opt,name=uptimeSec,proto3" json:"uptimeSec,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TunStatsResponse) Reset() {
+	*x = TunStatsResponse{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TunStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunStatsResponse) ProtoMessage() {}
+
+func (x *TunStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunStatsResponse.ProtoReflect.Descriptor instead.
+func (*TunStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TunStatsResponse) GetPacketsIn() uint64 {
+	if x != nil {
+		return x.PacketsIn
+	}
+	return 0
+}
+
+func (x *TunStatsResponse) GetPacketsOut() uint64 {
+	if x != nil {
+		return x.PacketsOut
+	}
+	return 0
+}
+
+func (x *TunStatsResponse) GetBytesIn() uint64 {
+	if x != nil {
+		return x.BytesIn
+	}
+	return 0
+}
+
+func (x *TunStatsResponse) GetBytesOut() uint64 {
+	if x != nil {
+		return x.BytesOut
+	}
+	return 0
+}
+
+func (x *TunStatsResponse) GetDroppedPackets() uint64 {
+	if x != nil {
+		return x.DroppedPackets
+	}
+	return 0
+}
+
+func (x *TunStatsResponse) GetTcpSessions() int32 {
+	if x != nil {
+		return x.TcpSessions
+	}
+	return 0
+}
+
+func (x *TunStatsResponse) GetUdpSessions() int32 {
+	if x != nil {
+		return x.UdpSessions
+	}
+	return 0
+}
+
+func (x *TunStatsResponse) GetUptimeSec() int64 {
+	if x != nil {
+		return x.UptimeSec
+	}
+	return 0
+}
+
+type ListConnectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connections   []*Connection          `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConnectionsResponse) Reset() {
+	*x = ListConnectionsResponse{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConnectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConnectionsResponse) ProtoMessage() {}
+
+func (x *ListConnectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConnectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListConnectionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListConnectionsResponse) GetConnections() []*Connection {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+type Connection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CoreName      string                 `protobuf:"bytes,2,opt,name=coreName,proto3" json:"coreName,omitempty"`
+	Network       string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Destination   string                 `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination,omitempty"`
+	InboundTag    string                 `protobuf:"bytes,6,opt,name=inboundTag,proto3" json:"inboundTag,omitempty"`
+	OutboundTag   string                 `protobuf:"bytes,7,opt,name=outboundTag,proto3" json:"outboundTag,omitempty"`
+	StartTime     int64                  `protobuf:"varint,8,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	Uplink        int64                  `protobuf:"varint,9,opt,name=uplink,proto3" json:"uplink,omitempty"`
+	Downlink      int64                  `protobuf:"varint,10,opt,name=downlink,proto3" json:"downlink,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Connection) Reset() {
+	*x = Connection{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Connection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Connection) ProtoMessage() {}
+
+func (x *Connection) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Connection.ProtoReflect.Descriptor instead.
+func (*Connection) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Connection) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Connection) GetCoreName() string {
+	if x != nil {
+		return x.CoreName
+	}
+	return ""
+}
+
+func (x *Connection) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Connection) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Connection) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *Connection) GetInboundTag() string {
+	if x != nil {
+		return x.InboundTag
+	}
+	return ""
+}
+
+func (x *Connection) GetOutboundTag() string {
+	if x != nil {
+		return x.OutboundTag
+	}
+	return ""
+}
+
+func (x *Connection) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *Connection) GetUplink() int64 {
+	if x != nil {
+		return x.Uplink
+	}
+	return 0
+}
+
+func (x *Connection) GetDownlink() int64 {
+	if x != nil {
+		return x.Downlink
+	}
+	return 0
+}
+
+type ConvertShareLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        string                 `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertShareLinkResponse) Reset() {
+	*x = ConvertShareLinkResponse{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertShareLinkResponse) ProtoMessage() {}
+
+func (x *ConvertShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertShareLinkResponse.ProtoReflect.Descriptor instead.
+func (*ConvertShareLinkResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ConvertShareLinkResponse) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+func (x *ConvertShareLinkResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type FetchSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Entries       []*SubscriptionEntry   `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	Userinfo      *SubscriptionUserinfo  `protobuf:"bytes,3,opt,name=userinfo,proto3" json:"userinfo,omitempty"` // Unset when the provider sent no Subscription-Userinfo
+	Skipped       int32                  `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchSubscriptionResponse) Reset() {
+	*x = FetchSubscriptionResponse{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchSubscriptionResponse) ProtoMessage() {}
+
+func (x *FetchSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*FetchSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FetchSubscriptionResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *FetchSubscriptionResponse) GetEntries() []*SubscriptionEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *FetchSubscriptionResponse) GetUserinfo() *SubscriptionUserinfo {
+	if x != nil {
+		return x.Userinfo
+	}
+	return nil
+}
+
+func (x *FetchSubscriptionResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+type SubscriptionEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Remark        string                 `protobuf:"bytes,2,opt,name=remark,proto3" json:"remark,omitempty"`
+	Protocol      string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Port          int32                  `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`
+	Link          string                 `protobuf:"bytes,6,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscriptionEntry) Reset() {
+	*x = SubscriptionEntry{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionEntry) ProtoMessage() {}
+
+func (x *SubscriptionEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionEntry.ProtoReflect.Descriptor instead.
+func (*SubscriptionEntry) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SubscriptionEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubscriptionEntry) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *SubscriptionEntry) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *SubscriptionEntry) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SubscriptionEntry) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *SubscriptionEntry) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+type SubscriptionUserinfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Upload        int64                  `protobuf:"varint,1,opt,name=upload,proto3" json:"upload,omitempty"`
+	Download      int64                  `protobuf:"varint,2,opt,name=download,proto3" json:"download,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Expire        int64                  `protobuf:"varint,4,opt,name=expire,proto3" json:"expire,omitempty"` // Unix millis, 0 when it never expires
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscriptionUserinfo) Reset() {
+	*x = SubscriptionUserinfo{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionUserinfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionUserinfo) ProtoMessage() {}
+
+func (x *SubscriptionUserinfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionUserinfo.ProtoReflect.Descriptor instead.
+func (*SubscriptionUserinfo) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SubscriptionUserinfo) GetUpload() int64 {
+	if x != nil {
+		return x.Upload
+	}
+	return 0
+}
+
+func (x *SubscriptionUserinfo) GetDownload() int64 {
+	if x != nil {
+		return x.Download
+	}
+	return 0
+}
+
+func (x *SubscriptionUserinfo) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SubscriptionUserinfo) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+type TestConfigResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"` // Position in TestConfigsRequest.configs
+	CoreName      string                 `protobuf:"bytes,3,opt,name=coreName,proto3" json:"coreName,omitempty"`
+	ConnectDelay  int64                  `protobuf:"varint,4,opt,name=connectDelay,proto3" json:"connectDelay,omitempty"` // Milliseconds, phases that did not run are 0
+	TlsDelay      int64                  `protobuf:"varint,5,opt,name=tlsDelay,proto3" json:"tlsDelay,omitempty"`
+	HttpDelay     int64                  `protobuf:"varint,6,opt,name=httpDelay,proto3" json:"httpDelay,omitempty"`
+	Delay         int64                  `protobuf:"varint,7,opt,name=delay,proto3" json:"delay,omitempty"` // Whole request, -1 on failure
+	StatusCode    int32                  `protobuf:"varint,8,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCategory PingErrorCategory      `protobuf:"varint,10,opt,name=errorCategory,proto3,enum=ProxyCore.PingErrorCategory" json:"errorCategory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestConfigResult) Reset() {
+	*x = TestConfigResult{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestConfigResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestConfigResult) ProtoMessage() {}
+
+func (x *TestConfigResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestConfigResult.ProtoReflect.Descriptor instead.
+func (*TestConfigResult) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TestConfigResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TestConfigResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TestConfigResult) GetCoreName() string {
+	if x != nil {
+		return x.CoreName
+	}
+	return ""
+}
+
+func (x *TestConfigResult) GetConnectDelay() int64 {
+	if x != nil {
+		return x.ConnectDelay
+	}
+	return 0
+}
+
+func (x *TestConfigResult) GetTlsDelay() int64 {
+	if x != nil {
+		return x.TlsDelay
+	}
+	return 0
+}
+
+func (x *TestConfigResult) GetHttpDelay() int64 {
+	if x != nil {
+		return x.HttpDelay
+	}
+	return 0
+}
+
+func (x *TestConfigResult) GetDelay() int64 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+func (x *TestConfigResult) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *TestConfigResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TestConfigResult) GetErrorCategory() PingErrorCategory {
+	if x != nil {
+		return x.ErrorCategory
+	}
+	return PingErrorCategory_PING_ERROR_NONE
+}
+
+type OutboundStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Selected      string                 `protobuf:"bytes,1,opt,name=selected,proto3" json:"selected,omitempty"` // Outbound tag the balancer currently picks, empty outside pool mode
+	Outbounds     []*OutboundHealth      `protobuf:"bytes,2,rep,name=outbounds,proto3" json:"outbounds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutboundStatusResponse) Reset() {
+	*x = OutboundStatusResponse{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutboundStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboundStatusResponse) ProtoMessage() {}
+
+func (x *OutboundStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboundStatusResponse.ProtoReflect.Descriptor instead.
+func (*OutboundStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *OutboundStatusResponse) GetSelected() string {
+	if x != nil {
+		return x.Selected
+	}
+	return ""
+}
+
+func (x *OutboundStatusResponse) GetOutbounds() []*OutboundHealth {
+	if x != nil {
+		return x.Outbounds
+	}
+	return nil
+}
+
+type OutboundHealth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Alive         bool                   `protobuf:"varint,3,opt,name=alive,proto3" json:"alive,omitempty"`
+	Delay         int64                  `protobuf:"varint,4,opt,name=delay,proto3" json:"delay,omitempty"`               // Last probe in milliseconds, -1 when not alive
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`                // Last failure reason
+	LastSeenTime  int64                  `protobuf:"varint,6,opt,name=lastSeenTime,proto3" json:"lastSeenTime,omitempty"` // Unix millis of the last successful probe
+	LastTryTime   int64                  `protobuf:"varint,7,opt,name=lastTryTime,proto3" json:"lastTryTime,omitempty"`   // Unix millis of the last probe
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutboundHealth) Reset() {
+	*x = OutboundHealth{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutboundHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboundHealth) ProtoMessage() {}
+
+func (x *OutboundHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboundHealth.ProtoReflect.Descriptor instead.
+func (*OutboundHealth) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *OutboundHealth) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *OutboundHealth) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OutboundHealth) GetAlive() bool {
+	if x != nil {
+		return x.Alive
+	}
+	return false
+}
+
+func (x *OutboundHealth) GetDelay() int64 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+func (x *OutboundHealth) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *OutboundHealth) GetLastSeenTime() int64 {
+	if x != nil {
+		return x.LastSeenTime
+	}
+	return 0
+}
+
+func (x *OutboundHealth) GetLastTryTime() int64 {
+	if x != nil {
+		return x.LastTryTime
+	}
+	return 0
+}
+
+type LogEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seq           uint64                 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Level         string                 `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	CoreName      string                 `protobuf:"bytes,4,opt,name=coreName,proto3" json:"coreName,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogEntry) Reset() {
+	*x = LogEntry{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogEntry) ProtoMessage() {}
+
+func (x *LogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LogEntry) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *LogEntry) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *LogEntry) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *LogEntry) GetCoreName() string {
+	if x != nil {
+		return x.CoreName
+	}
+	return ""
+}
+
+func (x *LogEntry) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CoreStateEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Component     string                 `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	State         CoreState              `protobuf:"varint,2,opt,name=state,proto3,enum=ProxyCore.CoreState" json:"state,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoreStateEvent) Reset() {
+	*x = CoreStateEvent{}
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoreStateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoreStateEvent) ProtoMessage() {}
+
+func (x *CoreStateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoreStateEvent.ProtoReflect.Descriptor instead.
+func (*CoreStateEvent) Descriptor() ([]byte, []int) {
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CoreStateEvent) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *CoreStateEvent) GetState() CoreState {
+	if x != nil {
+		return x.State
+	}
+	return CoreState_CORE_STATE_STOPPED
+}
+
+func (x *CoreStateEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CoreStateEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Empty) Reset() {
 	*x = Empty{}
-	mi := &file_proto_ProxyCoreService_proto_msgTypes[7]
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -413,7 +2383,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_ProxyCoreService_proto_msgTypes[7]
+	mi := &file_proto_ProxyCoreService_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,14 +2396,14 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{7}
+	return file_proto_ProxyCoreService_proto_rawDescGZIP(), []int{32}
 }
 
 var File_proto_ProxyCoreService_proto protoreflect.FileDescriptor
 
 const file_proto_ProxyCoreService_proto_rawDesc = "" +
 	"\n" +
-	"\x1cproto/ProxyCoreService.proto\x12\tProxyCore\"\xde\x01\n" +
+	"\x1cproto/ProxyCoreService.proto\x12\tProxyCore\"\x8f\x03\n" +
 	"\x10StartCoreRequest\x12\x1a\n" +
 	"\bcoreName\x18\x01 \x01(\tR\bcoreName\x12\x10\n" +
 	"\x03dir\x18\x02 \x01(\tR\x03dir\x12\x16\n" +
@@ -442,9 +2412,69 @@ const file_proto_ProxyCoreService_proto_rawDesc = "" +
 	"\bisString\x18\x05 \x01(\bR\bisString\x12\x1c\n" +
 	"\tisVpnMode\x18\x06 \x01(\bR\tisVpnMode\x12\x14\n" +
 	"\x05tunFD\x18\a \x01(\rR\x05tunFD\x12\x1c\n" +
-	"\tproxyPort\x18\b \x01(\x05R\tproxyPort\"&\n" +
+	"\tproxyPort\x18\b \x01(\x05R\tproxyPort\x12*\n" +
+	"\x04pool\x18\t \x01(\v2\x16.ProxyCore.PoolOptionsR\x04pool\x12\x1a\n" +
+	"\bhttpPort\x18\n" +
+	" \x01(\x05R\bhttpPort\x12\x14\n" +
+	"\x05mixed\x18\v \x01(\bR\x05mixed\x12(\n" +
+	"\x04auth\x18\f \x01(\v2\x14.ProxyCore.ProxyAuthR\x04auth\x12'\n" +
+	"\x03tun\x18\r \x01(\v2\x15.ProxyCore.TunOptionsR\x03tun\"\xa7\x03\n" +
+	"\n" +
+	"TunOptions\x12\x10\n" +
+	"\x03mtu\x18\x01 \x01(\x05R\x03mtu\x12$\n" +
+	"\rudpTimeoutSec\x18\x02 \x01(\x05R\rudpTimeoutSec\x12.\n" +
+	"\x12tcpSendBufferBytes\x18\x03 \x01(\x05R\x12tcpSendBufferBytes\x124\n" +
+	"\x15tcpReceiveBufferBytes\x18\x04 \x01(\x05R\x15tcpReceiveBufferBytes\x12:\n" +
+	"\x18tcpModerateReceiveBuffer\x18\x05 \x01(\bR\x18tcpModerateReceiveBuffer\x12\x1a\n" +
+	"\blogLevel\x18\x06 \x01(\tR\blogLevel\x12\x18\n" +
+	"\arestApi\x18\a \x01(\tR\arestApi\x12(\n" +
+	"\x0fmulticastGroups\x18\b \x03(\tR\x0fmulticastGroups\x12*\n" +
+	"\x03dns\x18\t \x01(\v2\x18.ProxyCore.TunDnsOptionsR\x03dns\x123\n" +
+	"\x06bypass\x18\n" +
+	" \x01(\v2\x1b.ProxyCore.TunBypassOptionsR\x06bypass\"g\n" +
+	"\rTunDnsOptions\x12\x1c\n" +
+	"\tupstreams\x18\x01 \x03(\tR\tupstreams\x12\x16\n" +
+	"\x06fakeIp\x18\x02 \x01(\bR\x06fakeIp\x12 \n" +
+	"\vfakeIpRange\x18\x03 \x01(\tR\vfakeIpRange\"r\n" +
+	"\x10TunBypassOptions\x12\x18\n" +
+	"\aprivate\x18\x01 \x01(\bR\aprivate\x12\x14\n" +
+	"\x05cidrs\x18\x02 \x03(\tR\x05cidrs\x12\x18\n" +
+	"\adomains\x18\x03 \x03(\tR\adomains\x12\x14\n" +
+	"\x05geoip\x18\x04 \x03(\tR\x05geoip\"C\n" +
+	"\tProxyAuth\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x8b\x01\n" +
+	"\vPoolOptions\x12\x18\n" +
+	"\aservers\x18\x01 \x03(\tR\aservers\x12\x1a\n" +
+	"\bstrategy\x18\x02 \x01(\tR\bstrategy\x12\x1a\n" +
+	"\bprobeUrl\x18\x03 \x01(\tR\bprobeUrl\x12*\n" +
+	"\x10probeIntervalSec\x18\x04 \x01(\x05R\x10probeIntervalSec\"&\n" +
 	"\x12MeasurePingRequest\x12\x10\n" +
-	"\x03url\x18\x01 \x03(\tR\x03url\"+\n" +
+	"\x03url\x18\x01 \x03(\tR\x03url\";\n" +
+	"\x11StreamLogsRequest\x12&\n" +
+	"\x0eincludeBacklog\x18\x01 \x01(\bR\x0eincludeBacklog\";\n" +
+	"\x13TrafficStatsRequest\x12$\n" +
+	"\rresetCounters\x18\x01 \x01(\bR\rresetCounters\"(\n" +
+	"\x16CloseConnectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"K\n" +
+	"\x17ConvertShareLinkRequest\x12\x12\n" +
+	"\x04link\x18\x01 \x01(\tR\x04link\x12\x1c\n" +
+	"\tproxyPort\x18\x02 \x01(\x05R\tproxyPort\"\x82\x01\n" +
+	"\x18FetchSubscriptionRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x18\n" +
+	"\aviaCore\x18\x02 \x01(\bR\aviaCore\x12\x1c\n" +
+	"\tuserAgent\x18\x03 \x01(\tR\tuserAgent\x12\x1c\n" +
+	"\ttimeoutMs\x18\x04 \x01(\x05R\ttimeoutMs\"\x97\x01\n" +
+	"\x12TestConfigsRequest\x12/\n" +
+	"\aconfigs\x18\x01 \x03(\v2\x15.ProxyCore.TestConfigR\aconfigs\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12 \n" +
+	"\vconcurrency\x18\x03 \x01(\x05R\vconcurrency\x12\x1c\n" +
+	"\ttimeoutMs\x18\x04 \x01(\x05R\ttimeoutMs\"P\n" +
+	"\n" +
+	"TestConfig\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bcoreName\x18\x02 \x01(\tR\bcoreName\x12\x16\n" +
+	"\x06config\x18\x03 \x01(\tR\x06config\"+\n" +
 	"\x0fBooleanResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\bR\amessage\"+\n" +
 	"\x0fVersionResponse\x12\x18\n" +
@@ -452,12 +2482,127 @@ const file_proto_ProxyCoreService_proto_rawDesc = "" +
 	"\vLogResponse\x12\x12\n" +
 	"\x04logs\x18\x01 \x01(\tR\x04logs\"F\n" +
 	"\x13MeasurePingResponse\x12/\n" +
-	"\aresults\x18\x01 \x03(\v2\x15.ProxyCore.PingResultR\aresults\"4\n" +
+	"\aresults\x18\x01 \x03(\v2\x15.ProxyCore.PingResultR\aresults\"\xb2\x02\n" +
 	"\n" +
 	"PingResult\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
-	"\x05delay\x18\x02 \x01(\x03R\x05delay\"\a\n" +
-	"\x05Empty2\xa8\x03\n" +
+	"\x05delay\x18\x02 \x01(\x03R\x05delay\x12\x1a\n" +
+	"\bdnsDelay\x18\x03 \x01(\x03R\bdnsDelay\x12\"\n" +
+	"\fconnectDelay\x18\x04 \x01(\x03R\fconnectDelay\x12\x1a\n" +
+	"\btlsDelay\x18\x05 \x01(\x03R\btlsDelay\x12&\n" +
+	"\x0efirstByteDelay\x18\x06 \x01(\x03R\x0efirstByteDelay\x12\x1e\n" +
+	"\n" +
+	"statusCode\x18\a \x01(\x05R\n" +
+	"statusCode\x12B\n" +
+	"\rerrorCategory\x18\b \x01(\x0e2\x1c.ProxyCore.PingErrorCategoryR\rerrorCategory\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\"\xb0\x01\n" +
+	"\x14TrafficStatsResponse\x12\x16\n" +
+	"\x06uplink\x18\x01 \x01(\x03R\x06uplink\x12\x1a\n" +
+	"\bdownlink\x18\x02 \x01(\x03R\bdownlink\x12\x1e\n" +
+	"\n" +
+	"uplinkRate\x18\x03 \x01(\x03R\n" +
+	"uplinkRate\x12\"\n" +
+	"\fdownlinkRate\x18\x04 \x01(\x03R\fdownlinkRate\x12 \n" +
+	"\vconnections\x18\x05 \x01(\x05R\vconnections\"\x90\x02\n" +
+	"\x10TunStatsResponse\x12\x1c\n" +
+	"\tpacketsIn\x18\x01 \x01(\x04R\tpacketsIn\x12\x1e\n" +
+	"\n" +
+	"packetsOut\x18\x02 \x01(\x04R\n" +
+	"packetsOut\x12\x18\n" +
+	"\abytesIn\x18\x03 \x01(\x04R\abytesIn\x12\x1a\n" +
+	"\bbytesOut\x18\x04 \x01(\x04R\bbytesOut\x12&\n" +
+	"\x0edroppedPackets\x18\x05 \x01(\x04R\x0edroppedPackets\x12 \n" +
+	"\vtcpSessions\x18\x06 \x01(\x05R\vtcpSessions\x12 \n" +
+	"\vudpSessions\x18\a \x01(\x05R\vudpSessions\x12\x1c\n" +
+	"\tuptimeSec\x18\b \x01(\x03R\tuptimeSec\"R\n" +
+	"\x17ListConnectionsResponse\x127\n" +
+	"\vconnections\x18\x01 \x03(\v2\x15.ProxyCore.ConnectionR\vconnections\"\xa0\x02\n" +
+	"\n" +
+	"Connection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1a\n" +
+	"\bcoreName\x18\x02 \x01(\tR\bcoreName\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12 \n" +
+	"\vdestination\x18\x05 \x01(\tR\vdestination\x12\x1e\n" +
+	"\n" +
+	"inboundTag\x18\x06 \x01(\tR\n" +
+	"inboundTag\x12 \n" +
+	"\voutboundTag\x18\a \x01(\tR\voutboundTag\x12\x1c\n" +
+	"\tstartTime\x18\b \x01(\x03R\tstartTime\x12\x16\n" +
+	"\x06uplink\x18\t \x01(\x03R\x06uplink\x12\x1a\n" +
+	"\bdownlink\x18\n" +
+	" \x01(\x03R\bdownlink\"F\n" +
+	"\x18ConvertShareLinkResponse\x12\x16\n" +
+	"\x06config\x18\x01 \x01(\tR\x06config\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xc0\x01\n" +
+	"\x19FetchSubscriptionResponse\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x126\n" +
+	"\aentries\x18\x02 \x03(\v2\x1c.ProxyCore.SubscriptionEntryR\aentries\x12;\n" +
+	"\buserinfo\x18\x03 \x01(\v2\x1f.ProxyCore.SubscriptionUserinfoR\buserinfo\x12\x18\n" +
+	"\askipped\x18\x04 \x01(\x05R\askipped\"\x9d\x01\n" +
+	"\x11SubscriptionEntry\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06remark\x18\x02 \x01(\tR\x06remark\x12\x1a\n" +
+	"\bprotocol\x18\x03 \x01(\tR\bprotocol\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04port\x18\x05 \x01(\x05R\x04port\x12\x12\n" +
+	"\x04link\x18\x06 \x01(\tR\x04link\"x\n" +
+	"\x14SubscriptionUserinfo\x12\x16\n" +
+	"\x06upload\x18\x01 \x01(\x03R\x06upload\x12\x1a\n" +
+	"\bdownload\x18\x02 \x01(\x03R\bdownload\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x16\n" +
+	"\x06expire\x18\x04 \x01(\x03R\x06expire\"\xc2\x02\n" +
+	"\x10TestConfigResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x05R\x05index\x12\x1a\n" +
+	"\bcoreName\x18\x03 \x01(\tR\bcoreName\x12\"\n" +
+	"\fconnectDelay\x18\x04 \x01(\x03R\fconnectDelay\x12\x1a\n" +
+	"\btlsDelay\x18\x05 \x01(\x03R\btlsDelay\x12\x1c\n" +
+	"\thttpDelay\x18\x06 \x01(\x03R\thttpDelay\x12\x14\n" +
+	"\x05delay\x18\a \x01(\x03R\x05delay\x12\x1e\n" +
+	"\n" +
+	"statusCode\x18\b \x01(\x05R\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12B\n" +
+	"\rerrorCategory\x18\n" +
+	" \x01(\x0e2\x1c.ProxyCore.PingErrorCategoryR\rerrorCategory\"m\n" +
+	"\x16OutboundStatusResponse\x12\x1a\n" +
+	"\bselected\x18\x01 \x01(\tR\bselected\x127\n" +
+	"\toutbounds\x18\x02 \x03(\v2\x19.ProxyCore.OutboundHealthR\toutbounds\"\xbe\x01\n" +
+	"\x0eOutboundHealth\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05alive\x18\x03 \x01(\bR\x05alive\x12\x14\n" +
+	"\x05delay\x18\x04 \x01(\x03R\x05delay\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\"\n" +
+	"\flastSeenTime\x18\x06 \x01(\x03R\flastSeenTime\x12 \n" +
+	"\vlastTryTime\x18\a \x01(\x03R\vlastTryTime\"\x86\x01\n" +
+	"\bLogEntry\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x04R\x03seq\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\tR\x05level\x12\x1a\n" +
+	"\bcoreName\x18\x04 \x01(\tR\bcoreName\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"\x8e\x01\n" +
+	"\x0eCoreStateEvent\x12\x1c\n" +
+	"\tcomponent\x18\x01 \x01(\tR\tcomponent\x12*\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x14.ProxyCore.CoreStateR\x05state\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp\"\a\n" +
+	"\x05Empty*\xb1\x01\n" +
+	"\x11PingErrorCategory\x12\x13\n" +
+	"\x0fPING_ERROR_NONE\x10\x00\x12\x16\n" +
+	"\x12PING_ERROR_TIMEOUT\x10\x01\x12\x16\n" +
+	"\x12PING_ERROR_REFUSED\x10\x02\x12\x12\n" +
+	"\x0ePING_ERROR_TLS\x10\x03\x12\x19\n" +
+	"\x15PING_ERROR_BAD_STATUS\x10\x04\x12\x12\n" +
+	"\x0ePING_ERROR_DNS\x10\x05\x12\x14\n" +
+	"\x10PING_ERROR_OTHER\x10\x06*\x84\x01\n" +
+	"\tCoreState\x12\x16\n" +
+	"\x12CORE_STATE_STOPPED\x10\x00\x12\x17\n" +
+	"\x13CORE_STATE_STARTING\x10\x01\x12\x16\n" +
+	"\x12CORE_STATE_RUNNING\x10\x02\x12\x17\n" +
+	"\x13CORE_STATE_STOPPING\x10\x03\x12\x15\n" +
+	"\x11CORE_STATE_FAILED\x10\x042\xe0\t\n" +
 	"\tProxyCore\x12:\n" +
 	"\tstartCore\x12\x1b.ProxyCore.StartCoreRequest\x1a\x10.ProxyCore.Empty\x12.\n" +
 	"\bstopCore\x12\x10.ProxyCore.Empty\x1a\x10.ProxyCore.Empty\x12=\n" +
@@ -466,7 +2611,20 @@ const file_proto_ProxyCoreService_proto_rawDesc = "" +
 	"getVersion\x12\x10.ProxyCore.Empty\x1a\x1a.ProxyCore.VersionResponse\x125\n" +
 	"\tfetchLogs\x12\x10.ProxyCore.Empty\x1a\x16.ProxyCore.LogResponse\x12/\n" +
 	"\tclearLogs\x12\x10.ProxyCore.Empty\x1a\x10.ProxyCore.Empty\x12L\n" +
-	"\vmeasurePing\x12\x1d.ProxyCore.MeasurePingRequest\x1a\x1e.ProxyCore.MeasurePingResponseB\x11Z\x0fproxycoreproto/b\x06proto3"
+	"\vmeasurePing\x12\x1d.ProxyCore.MeasurePingRequest\x1a\x1e.ProxyCore.MeasurePingResponse\x12A\n" +
+	"\n" +
+	"streamLogs\x12\x1c.ProxyCore.StreamLogsRequest\x1a\x13.ProxyCore.LogEntry0\x01\x12?\n" +
+	"\x0ewatchCoreState\x12\x10.ProxyCore.Empty\x1a\x19.ProxyCore.CoreStateEvent0\x01\x12R\n" +
+	"\x0fgetTrafficStats\x12\x1e.ProxyCore.TrafficStatsRequest\x1a\x1f.ProxyCore.TrafficStatsResponse\x12G\n" +
+	"\x0flistConnections\x12\x10.ProxyCore.Empty\x1a\".ProxyCore.ListConnectionsResponse\x12F\n" +
+	"\x0fcloseConnection\x12!.ProxyCore.CloseConnectionRequest\x1a\x10.ProxyCore.Empty\x12;\n" +
+	"\n" +
+	"reloadCore\x12\x1b.ProxyCore.StartCoreRequest\x1a\x10.ProxyCore.Empty\x12[\n" +
+	"\x10convertShareLink\x12\".ProxyCore.ConvertShareLinkRequest\x1a#.ProxyCore.ConvertShareLinkResponse\x12^\n" +
+	"\x11fetchSubscription\x12#.ProxyCore.FetchSubscriptionRequest\x1a$.ProxyCore.FetchSubscriptionResponse\x12K\n" +
+	"\vtestConfigs\x12\x1d.ProxyCore.TestConfigsRequest\x1a\x1b.ProxyCore.TestConfigResult0\x01\x12H\n" +
+	"\x11getOutboundStatus\x12\x10.ProxyCore.Empty\x1a!.ProxyCore.OutboundStatusResponse\x12<\n" +
+	"\vgetTunStats\x12\x10.ProxyCore.Empty\x1a\x1b.ProxyCore.TunStatsResponseB\x11Z\x0fproxycoreproto/b\x06proto3"
 
 var (
 	file_proto_ProxyCoreService_proto_rawDescOnce sync.Once
@@ -480,38 +2638,101 @@ func file_proto_ProxyCoreService_proto_rawDescGZIP() []byte {
 	return file_proto_ProxyCoreService_proto_rawDescData
 }
 
-var file_proto_ProxyCoreService_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_ProxyCoreService_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_ProxyCoreService_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_proto_ProxyCoreService_proto_goTypes = []any{
-	(*StartCoreRequest)(nil),    // 0: ProxyCore.StartCoreRequest
-	(*MeasurePingRequest)(nil),  // 1: ProxyCore.MeasurePingRequest
-	(*BooleanResponse)(nil),     // 2: ProxyCore.BooleanResponse
-	(*VersionResponse)(nil),     // 3: ProxyCore.VersionResponse
-	(*LogResponse)(nil),         // 4: ProxyCore.LogResponse
-	(*MeasurePingResponse)(nil), // 5: ProxyCore.MeasurePingResponse
-	(*PingResult)(nil),          // 6: ProxyCore.PingResult
-	(*Empty)(nil),               // 7: ProxyCore.Empty
+	(PingErrorCategory)(0),            // 0: ProxyCore.PingErrorCategory
+	(CoreState)(0),                    // 1: ProxyCore.CoreState
+	(*StartCoreRequest)(nil),          // 2: ProxyCore.StartCoreRequest
+	(*TunOptions)(nil),                // 3: ProxyCore.TunOptions
+	(*TunDnsOptions)(nil),             // 4: ProxyCore.TunDnsOptions
+	(*TunBypassOptions)(nil),          // 5: ProxyCore.TunBypassOptions
+	(*ProxyAuth)(nil),                 // 6: ProxyCore.ProxyAuth
+	(*PoolOptions)(nil),               // 7: ProxyCore.PoolOptions
+	(*MeasurePingRequest)(nil),        // 8: ProxyCore.MeasurePingRequest
+	(*StreamLogsRequest)(nil),         // 9: ProxyCore.StreamLogsRequest
+	(*TrafficStatsRequest)(nil),       // 10: ProxyCore.TrafficStatsRequest
+	(*CloseConnectionRequest)(nil),    // 11: ProxyCore.CloseConnectionRequest
+	(*ConvertShareLinkRequest)(nil),   // 12: ProxyCore.ConvertShareLinkRequest
+	(*FetchSubscriptionRequest)(nil),  // 13: ProxyCore.FetchSubscriptionRequest
+	(*TestConfigsRequest)(nil),        // 14: ProxyCore.TestConfigsRequest
+	(*TestConfig)(nil),                // 15: ProxyCore.TestConfig
+	(*BooleanResponse)(nil),           // 16: ProxyCore.BooleanResponse
+	(*VersionResponse)(nil),           // 17: ProxyCore.VersionResponse
+	(*LogResponse)(nil),               // 18: ProxyCore.LogResponse
+	(*MeasurePingResponse)(nil),       // 19: ProxyCore.MeasurePingResponse
+	(*PingResult)(nil),                // 20: ProxyCore.PingResult
+	(*TrafficStatsResponse)(nil),      // 21: ProxyCore.TrafficStatsResponse
+	(*TunStatsResponse)(nil),          // 22: ProxyCore.TunStatsResponse
+	(*ListConnectionsResponse)(nil),   // 23: ProxyCore.ListConnectionsResponse
+	(*Connection)(nil),                // 24: ProxyCore.Connection
+	(*ConvertShareLinkResponse)(nil),  // 25: ProxyCore.ConvertShareLinkResponse
+	(*FetchSubscriptionResponse)(nil), // 26: ProxyCore.FetchSubscriptionResponse
+	(*SubscriptionEntry)(nil),         // 27: ProxyCore.SubscriptionEntry
+	(*SubscriptionUserinfo)(nil),      // 28: ProxyCore.SubscriptionUserinfo
+	(*TestConfigResult)(nil),          // 29: ProxyCore.TestConfigResult
+	(*OutboundStatusResponse)(nil),    // 30: ProxyCore.OutboundStatusResponse
+	(*OutboundHealth)(nil),            // 31: ProxyCore.OutboundHealth
+	(*LogEntry)(nil),                  // 32: ProxyCore.LogEntry
+	(*CoreStateEvent)(nil),            // 33: ProxyCore.CoreStateEvent
+	(*Empty)(nil),                     // 34: ProxyCore.Empty
 }
 var file_proto_ProxyCoreService_proto_depIdxs = []int32{
-	6, // 0: ProxyCore.MeasurePingResponse.results:type_name -> ProxyCore.PingResult
-	0, // 1: ProxyCore.ProxyCore.startCore:input_type -> ProxyCore.StartCoreRequest
-	7, // 2: ProxyCore.ProxyCore.stopCore:input_type -> ProxyCore.Empty
-	7, // 3: ProxyCore.ProxyCore.isCoreRunning:input_type -> ProxyCore.Empty
-	7, // 4: ProxyCore.ProxyCore.getVersion:input_type -> ProxyCore.Empty
-	7, // 5: ProxyCore.ProxyCore.fetchLogs:input_type -> ProxyCore.Empty
-	7, // 6: ProxyCore.ProxyCore.clearLogs:input_type -> ProxyCore.Empty
-	1, // 7: ProxyCore.ProxyCore.measurePing:input_type -> ProxyCore.MeasurePingRequest
-	7, // 8: ProxyCore.ProxyCore.startCore:output_type -> ProxyCore.Empty
-	7, // 9: ProxyCore.ProxyCore.stopCore:output_type -> ProxyCore.Empty
-	2, // 10: ProxyCore.ProxyCore.isCoreRunning:output_type -> ProxyCore.BooleanResponse
-	3, // 11: ProxyCore.ProxyCore.getVersion:output_type -> ProxyCore.VersionResponse
-	4, // 12: ProxyCore.ProxyCore.fetchLogs:output_type -> ProxyCore.LogResponse
-	7, // 13: ProxyCore.ProxyCore.clearLogs:output_type -> ProxyCore.Empty
-	5, // 14: ProxyCore.ProxyCore.measurePing:output_type -> ProxyCore.MeasurePingResponse
-	8, // [8:15] is the sub-list for method output_type
-	1, // [1:8] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	7,  // 0: ProxyCore.StartCoreRequest.pool:type_name -> ProxyCore.PoolOptions
+	6,  // 1: ProxyCore.StartCoreRequest.auth:type_name -> ProxyCore.ProxyAuth
+	3,  // 2: ProxyCore.StartCoreRequest.tun:type_name -> ProxyCore.TunOptions
+	4,  // 3: ProxyCore.TunOptions.dns:type_name -> ProxyCore.TunDnsOptions
+	5,  // 4: ProxyCore.TunOptions.bypass:type_name -> ProxyCore.TunBypassOptions
+	15, // 5: ProxyCore.TestConfigsRequest.configs:type_name -> ProxyCore.TestConfig
+	20, // 6: ProxyCore.MeasurePingResponse.results:type_name -> ProxyCore.PingResult
+	0,  // 7: ProxyCore.PingResult.errorCategory:type_name -> ProxyCore.PingErrorCategory
+	24, // 8: ProxyCore.ListConnectionsResponse.connections:type_name -> ProxyCore.Connection
+	27, // 9: ProxyCore.FetchSubscriptionResponse.entries:type_name -> ProxyCore.SubscriptionEntry
+	28, // 10: ProxyCore.FetchSubscriptionResponse.userinfo:type_name -> ProxyCore.SubscriptionUserinfo
+	0,  // 11: ProxyCore.TestConfigResult.errorCategory:type_name -> ProxyCore.PingErrorCategory
+	31, // 12: ProxyCore.OutboundStatusResponse.outbounds:type_name -> ProxyCore.OutboundHealth
+	1,  // 13: ProxyCore.CoreStateEvent.state:type_name -> ProxyCore.CoreState
+	2,  // 14: ProxyCore.ProxyCore.startCore:input_type -> ProxyCore.StartCoreRequest
+	34, // 15: ProxyCore.ProxyCore.stopCore:input_type -> ProxyCore.Empty
+	34, // 16: ProxyCore.ProxyCore.isCoreRunning:input_type -> ProxyCore.Empty
+	34, // 17: ProxyCore.ProxyCore.getVersion:input_type -> ProxyCore.Empty
+	34, // 18: ProxyCore.ProxyCore.fetchLogs:input_type -> ProxyCore.Empty
+	34, // 19: ProxyCore.ProxyCore.clearLogs:input_type -> ProxyCore.Empty
+	8,  // 20: ProxyCore.ProxyCore.measurePing:input_type -> ProxyCore.MeasurePingRequest
+	9,  // 21: ProxyCore.ProxyCore.streamLogs:input_type -> ProxyCore.StreamLogsRequest
+	34, // 22: ProxyCore.ProxyCore.watchCoreState:input_type -> ProxyCore.Empty
+	10, // 23: ProxyCore.ProxyCore.getTrafficStats:input_type -> ProxyCore.TrafficStatsRequest
+	34, // 24: ProxyCore.ProxyCore.listConnections:input_type -> ProxyCore.Empty
+	11, // 25: ProxyCore.ProxyCore.closeConnection:input_type -> ProxyCore.CloseConnectionRequest
+	2,  // 26: ProxyCore.ProxyCore.reloadCore:input_type -> ProxyCore.StartCoreRequest
+	12, // 27: ProxyCore.ProxyCore.convertShareLink:input_type -> ProxyCore.ConvertShareLinkRequest
+	13, // 28: ProxyCore.ProxyCore.fetchSubscription:input_type -> ProxyCore.FetchSubscriptionRequest
+	14, // 29: ProxyCore.ProxyCore.testConfigs:input_type -> ProxyCore.TestConfigsRequest
+	34, // 30: ProxyCore.ProxyCore.getOutboundStatus:input_type -> ProxyCore.Empty
+	34, // 31: ProxyCore.ProxyCore.getTunStats:input_type -> ProxyCore.Empty
+	34, // 32: ProxyCore.ProxyCore.startCore:output_type -> ProxyCore.Empty
+	34, // 33: ProxyCore.ProxyCore.stopCore:output_type -> ProxyCore.Empty
+	16, // 34: ProxyCore.ProxyCore.isCoreRunning:output_type -> ProxyCore.BooleanResponse
+	17, // 35: ProxyCore.ProxyCore.getVersion:output_type -> ProxyCore.VersionResponse
+	18, // 36: ProxyCore.ProxyCore.fetchLogs:output_type -> ProxyCore.LogResponse
+	34, // 37: ProxyCore.ProxyCore.clearLogs:output_type -> ProxyCore.Empty
+	19, // 38: ProxyCore.ProxyCore.measurePing:output_type -> ProxyCore.MeasurePingResponse
+	32, // 39: ProxyCore.ProxyCore.streamLogs:output_type -> ProxyCore.LogEntry
+	33, // 40: ProxyCore.ProxyCore.watchCoreState:output_type -> ProxyCore.CoreStateEvent
+	21, // 41: ProxyCore.ProxyCore.getTrafficStats:output_type -> ProxyCore.TrafficStatsResponse
+	23, // 42: ProxyCore.ProxyCore.listConnections:output_type -> ProxyCore.ListConnectionsResponse
+	34, // 43: ProxyCore.ProxyCore.closeConnection:output_type -> ProxyCore.Empty
+	34, // 44: ProxyCore.ProxyCore.reloadCore:output_type -> ProxyCore.Empty
+	25, // 45: ProxyCore.ProxyCore.convertShareLink:output_type -> ProxyCore.ConvertShareLinkResponse
+	26, // 46: ProxyCore.ProxyCore.fetchSubscription:output_type -> ProxyCore.FetchSubscriptionResponse
+	29, // 47: ProxyCore.ProxyCore.testConfigs:output_type -> ProxyCore.TestConfigResult
+	30, // 48: ProxyCore.ProxyCore.getOutboundStatus:output_type -> ProxyCore.OutboundStatusResponse
+	22, // 49: ProxyCore.ProxyCore.getTunStats:output_type -> ProxyCore.TunStatsResponse
+	32, // [32:50] is the sub-list for method output_type
+	14, // [14:32] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_proto_ProxyCoreService_proto_init() }
@@ -524,13 +2745,14 @@ func file_proto_ProxyCoreService_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_ProxyCoreService_proto_rawDesc), len(file_proto_ProxyCoreService_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      2,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_ProxyCoreService_proto_goTypes,
 		DependencyIndexes: file_proto_ProxyCoreService_proto_depIdxs,
+		EnumInfos:         file_proto_ProxyCoreService_proto_enumTypes,
 		MessageInfos:      file_proto_ProxyCoreService_proto_msgTypes,
 	}.Build()
 	File_proto_ProxyCoreService_proto = out.File
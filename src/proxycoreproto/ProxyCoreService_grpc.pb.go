@@ -19,13 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProxyCore_StartCore_FullMethodName     = "/ProxyCore.ProxyCore/startCore"
-	ProxyCore_StopCore_FullMethodName      = "/ProxyCore.ProxyCore/stopCore"
-	ProxyCore_IsCoreRunning_FullMethodName = "/ProxyCore.ProxyCore/isCoreRunning"
-	ProxyCore_GetVersion_FullMethodName    = "/ProxyCore.ProxyCore/getVersion"
-	ProxyCore_FetchLogs_FullMethodName     = "/ProxyCore.ProxyCore/fetchLogs"
-	ProxyCore_ClearLogs_FullMethodName     = "/ProxyCore.ProxyCore/clearLogs"
-	ProxyCore_MeasurePing_FullMethodName   = "/ProxyCore.ProxyCore/measurePing"
+	ProxyCore_StartCore_FullMethodName         = "/ProxyCore.ProxyCore/startCore"
+	ProxyCore_StopCore_FullMethodName          = "/ProxyCore.ProxyCore/stopCore"
+	ProxyCore_IsCoreRunning_FullMethodName     = "/ProxyCore.ProxyCore/isCoreRunning"
+	ProxyCore_GetVersion_FullMethodName        = "/ProxyCore.ProxyCore/getVersion"
+	ProxyCore_FetchLogs_FullMethodName         = "/ProxyCore.ProxyCore/fetchLogs"
+	ProxyCore_ClearLogs_FullMethodName         = "/ProxyCore.ProxyCore/clearLogs"
+	ProxyCore_MeasurePing_FullMethodName       = "/ProxyCore.ProxyCore/measurePing"
+	ProxyCore_StreamLogs_FullMethodName        = "/ProxyCore.ProxyCore/streamLogs"
+	ProxyCore_WatchCoreState_FullMethodName    = "/ProxyCore.ProxyCore/watchCoreState"
+	ProxyCore_GetTrafficStats_FullMethodName   = "/ProxyCore.ProxyCore/getTrafficStats"
+	ProxyCore_ListConnections_FullMethodName   = "/ProxyCore.ProxyCore/listConnections"
+	ProxyCore_CloseConnection_FullMethodName   = "/ProxyCore.ProxyCore/closeConnection"
+	ProxyCore_ReloadCore_FullMethodName        = "/ProxyCore.ProxyCore/reloadCore"
+	ProxyCore_ConvertShareLink_FullMethodName  = "/ProxyCore.ProxyCore/convertShareLink"
+	ProxyCore_FetchSubscription_FullMethodName = "/ProxyCore.ProxyCore/fetchSubscription"
+	ProxyCore_TestConfigs_FullMethodName       = "/ProxyCore.ProxyCore/testConfigs"
+	ProxyCore_GetOutboundStatus_FullMethodName = "/ProxyCore.ProxyCore/getOutboundStatus"
+	ProxyCore_GetTunStats_FullMethodName       = "/ProxyCore.ProxyCore/getTunStats"
 )
 
 // ProxyCoreClient is the client API for ProxyCore service.
@@ -41,6 +52,17 @@ type ProxyCoreClient interface {
 	FetchLogs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LogResponse, error)
 	ClearLogs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 	MeasurePing(ctx context.Context, in *MeasurePingRequest, opts ...grpc.CallOption) (*MeasurePingResponse, error)
+	StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogEntry], error)
+	WatchCoreState(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CoreStateEvent], error)
+	GetTrafficStats(ctx context.Context, in *TrafficStatsRequest, opts ...grpc.CallOption) (*TrafficStatsResponse, error)
+	ListConnections(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListConnectionsResponse, error)
+	CloseConnection(ctx context.Context, in *CloseConnectionRequest, opts ...grpc.CallOption) (*Empty, error)
+	ReloadCore(ctx context.Context, in *StartCoreRequest, opts ...grpc.CallOption) (*Empty, error)
+	ConvertShareLink(ctx context.Context, in *ConvertShareLinkRequest, opts ...grpc.CallOption) (*ConvertShareLinkResponse, error)
+	FetchSubscription(ctx context.Context, in *FetchSubscriptionRequest, opts ...grpc.CallOption) (*FetchSubscriptionResponse, error)
+	TestConfigs(ctx context.Context, in *TestConfigsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TestConfigResult], error)
+	GetOutboundStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*OutboundStatusResponse, error)
+	GetTunStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TunStatsResponse, error)
 }
 
 type proxyCoreClient struct {
@@ -121,6 +143,143 @@ func (c *proxyCoreClient) MeasurePing(ctx context.Context, in *MeasurePingReques
 	return out, nil
 }
 
+func (c *proxyCoreClient) StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogEntry], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProxyCore_ServiceDesc.Streams[0], ProxyCore_StreamLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamLogsRequest, LogEntry]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProxyCore_StreamLogsClient = grpc.ServerStreamingClient[LogEntry]
+
+func (c *proxyCoreClient) WatchCoreState(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CoreStateEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProxyCore_ServiceDesc.Streams[1], ProxyCore_WatchCoreState_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Empty, CoreStateEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProxyCore_WatchCoreStateClient = grpc.ServerStreamingClient[CoreStateEvent]
+
+func (c *proxyCoreClient) GetTrafficStats(ctx context.Context, in *TrafficStatsRequest, opts ...grpc.CallOption) (*TrafficStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrafficStatsResponse)
+	err := c.cc.Invoke(ctx, ProxyCore_GetTrafficStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyCoreClient) ListConnections(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListConnectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConnectionsResponse)
+	err := c.cc.Invoke(ctx, ProxyCore_ListConnections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyCoreClient) CloseConnection(ctx context.Context, in *CloseConnectionRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ProxyCore_CloseConnection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyCoreClient) ReloadCore(ctx context.Context, in *StartCoreRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ProxyCore_ReloadCore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyCoreClient) ConvertShareLink(ctx context.Context, in *ConvertShareLinkRequest, opts ...grpc.CallOption) (*ConvertShareLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConvertShareLinkResponse)
+	err := c.cc.Invoke(ctx, ProxyCore_ConvertShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyCoreClient) FetchSubscription(ctx context.Context, in *FetchSubscriptionRequest, opts ...grpc.CallOption) (*FetchSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FetchSubscriptionResponse)
+	err := c.cc.Invoke(ctx, ProxyCore_FetchSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyCoreClient) TestConfigs(ctx context.Context, in *TestConfigsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TestConfigResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProxyCore_ServiceDesc.Streams[2], ProxyCore_TestConfigs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TestConfigsRequest, TestConfigResult]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProxyCore_TestConfigsClient = grpc.ServerStreamingClient[TestConfigResult]
+
+func (c *proxyCoreClient) GetOutboundStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*OutboundStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OutboundStatusResponse)
+	err := c.cc.Invoke(ctx, ProxyCore_GetOutboundStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyCoreClient) GetTunStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TunStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TunStatsResponse)
+	err := c.cc.Invoke(ctx, ProxyCore_GetTunStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProxyCoreServer is the server API for ProxyCore service.
 // All implementations must embed UnimplementedProxyCoreServer
 // for forward compatibility.
@@ -134,6 +293,17 @@ type ProxyCoreServer interface {
 	FetchLogs(context.Context, *Empty) (*LogResponse, error)
 	ClearLogs(context.Context, *Empty) (*Empty, error)
 	MeasurePing(context.Context, *MeasurePingRequest) (*MeasurePingResponse, error)
+	StreamLogs(*StreamLogsRequest, grpc.ServerStreamingServer[LogEntry]) error
+	WatchCoreState(*Empty, grpc.ServerStreamingServer[CoreStateEvent]) error
+	GetTrafficStats(context.Context, *TrafficStatsRequest) (*TrafficStatsResponse, error)
+	ListConnections(context.Context, *Empty) (*ListConnectionsResponse, error)
+	CloseConnection(context.Context, *CloseConnectionRequest) (*Empty, error)
+	ReloadCore(context.Context, *StartCoreRequest) (*Empty, error)
+	ConvertShareLink(context.Context, *ConvertShareLinkRequest) (*ConvertShareLinkResponse, error)
+	FetchSubscription(context.Context, *FetchSubscriptionRequest) (*FetchSubscriptionResponse, error)
+	TestConfigs(*TestConfigsRequest, grpc.ServerStreamingServer[TestConfigResult]) error
+	GetOutboundStatus(context.Context, *Empty) (*OutboundStatusResponse, error)
+	GetTunStats(context.Context, *Empty) (*TunStatsResponse, error)
 	mustEmbedUnimplementedProxyCoreServer()
 }
 
@@ -165,6 +335,39 @@ func (UnimplementedProxyCoreServer) ClearLogs(context.Context, *Empty) (*Empty,
 func (UnimplementedProxyCoreServer) MeasurePing(context.Context, *MeasurePingRequest) (*MeasurePingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MeasurePing not implemented")
 }
+func (UnimplementedProxyCoreServer) StreamLogs(*StreamLogsRequest, grpc.ServerStreamingServer[LogEntry]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLogs not implemented")
+}
+func (UnimplementedProxyCoreServer) WatchCoreState(*Empty, grpc.ServerStreamingServer[CoreStateEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCoreState not implemented")
+}
+func (UnimplementedProxyCoreServer) GetTrafficStats(context.Context, *TrafficStatsRequest) (*TrafficStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrafficStats not implemented")
+}
+func (UnimplementedProxyCoreServer) ListConnections(context.Context, *Empty) (*ListConnectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnections not implemented")
+}
+func (UnimplementedProxyCoreServer) CloseConnection(context.Context, *CloseConnectionRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseConnection not implemented")
+}
+func (UnimplementedProxyCoreServer) ReloadCore(context.Context, *StartCoreRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadCore not implemented")
+}
+func (UnimplementedProxyCoreServer) ConvertShareLink(context.Context, *ConvertShareLinkRequest) (*ConvertShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertShareLink not implemented")
+}
+func (UnimplementedProxyCoreServer) FetchSubscription(context.Context, *FetchSubscriptionRequest) (*FetchSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchSubscription not implemented")
+}
+func (UnimplementedProxyCoreServer) TestConfigs(*TestConfigsRequest, grpc.ServerStreamingServer[TestConfigResult]) error {
+	return status.Errorf(codes.Unimplemented, "method TestConfigs not implemented")
+}
+func (UnimplementedProxyCoreServer) GetOutboundStatus(context.Context, *Empty) (*OutboundStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutboundStatus not implemented")
+}
+func (UnimplementedProxyCoreServer) GetTunStats(context.Context, *Empty) (*TunStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTunStats not implemented")
+}
 func (UnimplementedProxyCoreServer) mustEmbedUnimplementedProxyCoreServer() {}
 func (UnimplementedProxyCoreServer) testEmbeddedByValue()                   {}
 
@@ -312,6 +515,183 @@ func _ProxyCore_MeasurePing_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProxyCore_StreamLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProxyCoreServer).StreamLogs(m, &grpc.GenericServerStream[StreamLogsRequest, LogEntry]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProxyCore_StreamLogsServer = grpc.ServerStreamingServer[LogEntry]
+
+func _ProxyCore_WatchCoreState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProxyCoreServer).WatchCoreState(m, &grpc.GenericServerStream[Empty, CoreStateEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProxyCore_WatchCoreStateServer = grpc.ServerStreamingServer[CoreStateEvent]
+
+func _ProxyCore_GetTrafficStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrafficStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyCoreServer).GetTrafficStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProxyCore_GetTrafficStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyCoreServer).GetTrafficStats(ctx, req.(*TrafficStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProxyCore_ListConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyCoreServer).ListConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProxyCore_ListConnections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyCoreServer).ListConnections(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProxyCore_CloseConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyCoreServer).CloseConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProxyCore_CloseConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyCoreServer).CloseConnection(ctx, req.(*CloseConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProxyCore_ReloadCore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartCoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyCoreServer).ReloadCore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProxyCore_ReloadCore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyCoreServer).ReloadCore(ctx, req.(*StartCoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProxyCore_ConvertShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyCoreServer).ConvertShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProxyCore_ConvertShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyCoreServer).ConvertShareLink(ctx, req.(*ConvertShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProxyCore_FetchSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyCoreServer).FetchSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProxyCore_FetchSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyCoreServer).FetchSubscription(ctx, req.(*FetchSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProxyCore_TestConfigs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TestConfigsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProxyCoreServer).TestConfigs(m, &grpc.GenericServerStream[TestConfigsRequest, TestConfigResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProxyCore_TestConfigsServer = grpc.ServerStreamingServer[TestConfigResult]
+
+func _ProxyCore_GetOutboundStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyCoreServer).GetOutboundStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProxyCore_GetOutboundStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyCoreServer).GetOutboundStatus(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProxyCore_GetTunStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyCoreServer).GetTunStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProxyCore_GetTunStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyCoreServer).GetTunStats(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProxyCore_ServiceDesc is the grpc.ServiceDesc for ProxyCore service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -347,7 +727,55 @@ var ProxyCore_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "measurePing",
 			Handler:    _ProxyCore_MeasurePing_Handler,
 		},
+		{
+			MethodName: "getTrafficStats",
+			Handler:    _ProxyCore_GetTrafficStats_Handler,
+		},
+		{
+			MethodName: "listConnections",
+			Handler:    _ProxyCore_ListConnections_Handler,
+		},
+		{
+			MethodName: "closeConnection",
+			Handler:    _ProxyCore_CloseConnection_Handler,
+		},
+		{
+			MethodName: "reloadCore",
+			Handler:    _ProxyCore_ReloadCore_Handler,
+		},
+		{
+			MethodName: "convertShareLink",
+			Handler:    _ProxyCore_ConvertShareLink_Handler,
+		},
+		{
+			MethodName: "fetchSubscription",
+			Handler:    _ProxyCore_FetchSubscription_Handler,
+		},
+		{
+			MethodName: "getOutboundStatus",
+			Handler:    _ProxyCore_GetOutboundStatus_Handler,
+		},
+		{
+			MethodName: "getTunStats",
+			Handler:    _ProxyCore_GetTunStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "streamLogs",
+			Handler:       _ProxyCore_StreamLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "watchCoreState",
+			Handler:       _ProxyCore_WatchCoreState_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "testConfigs",
+			Handler:       _ProxyCore_TestConfigs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/ProxyCoreService.proto",
 }
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// DefaultListenAddress is used when StartGRPCServer gets an empty listen spec.
+const DefaultListenAddress = "127.0.0.1:30051"
+
+const unixScheme = "unix://"
+
+// listen opens the gRPC listener described by spec and returns it together
+// with the address clients should dial. Supported specs:
+//
+//	host:port                     TCP, port 0 picks a free port
+//	unix:///path/to.sock          unix domain socket with 0600 permissions
+//	unix:///path/to.sock?mode=660 unix domain socket with the given octal mode
+//
+// Socket paths must be absolute: unix://relative.sock and unix://./to.sock
+// are rejected rather than read as a host name.
+func listen(spec string) (net.Listener, string, error) {
+	if spec == "" {
+		spec = DefaultListenAddress
+	}
+
+	if !strings.HasPrefix(spec, unixScheme) {
+		lis, err := net.Listen("tcp", spec)
+		if err != nil {
+			return nil, "", err
+		}
+		return lis, lis.Addr().String(), nil
+	}
+
+	u, err := url.Parse(spec)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid listen spec %q: %w", spec, err)
+	}
+	path := u.Path
+	if u.Host != "" || !strings.HasPrefix(path, "/") {
+		return nil, "", fmt.Errorf("invalid listen spec %q: socket path must be absolute, as in unix:///path/to.sock", spec)
+	}
+
+	mode := os.FileMode(0600)
+	if m := u.Query().Get("mode"); m != "" {
+		v, err := strconv.ParseUint(m, 8, 32)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid socket mode %q: %w", m, err)
+		}
+		mode = os.FileMode(v)
+	}
+
+	// A socket file left over from a previous process blocks the bind. It
+	// is only removed once nothing answers on it.
+	if fi, err := os.Lstat(path); err == nil && fi.Mode()&os.ModeSocket != 0 {
+		conn, err := net.DialTimeout("unix", path, time.Second)
+		if err == nil {
+			conn.Close()
+			return nil, "", fmt.Errorf("socket %s is in use by another server", path)
+		}
+		if !errors.Is(err, syscall.ECONNREFUSED) {
+			return nil, "", fmt.Errorf("check socket %s: %w", path, err)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, "", fmt.Errorf("remove stale socket %s: %w", path, err)
+		}
+	}
+
+	// The socket is created under the process umask and narrowed before
+	// anything is accepted on it.
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		lis.Close()
+		return nil, "", fmt.Errorf("chmod %s: %w", path, err)
+	}
+	return lis, unixScheme + path, nil
+}
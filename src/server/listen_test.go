@@ -0,0 +1,48 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestListenUnix(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "core.sock")
+	lis, addr, err := listen("unix://" + path + "?mode=640")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer lis.Close()
+	if addr != "unix://"+path {
+		t.Errorf("address = %q, want unix://%s", addr, path)
+	}
+	if runtime.GOOS != "windows" {
+		fi, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fi.Mode().Perm() != 0640 {
+			t.Errorf("mode = %v, want 0640", fi.Mode().Perm())
+		}
+	}
+
+	if _, _, err := listen("unix://" + path); err == nil || !strings.Contains(err.Error(), "in use") {
+		t.Errorf("second listen error = %v, want the socket in use", err)
+	}
+}
+
+func TestListenUnixRelative(t *testing.T) {
+	for _, spec := range []string{"unix://relative.sock", "unix://./core.sock", "unix://", "unix://host/core.sock"} {
+		lis, _, err := listen(spec)
+		if err == nil {
+			lis.Close()
+			t.Errorf("%s: listen succeeded, want an error", spec)
+			continue
+		}
+		if !strings.Contains(err.Error(), "must be absolute") {
+			t.Errorf("%s: error = %v, want a path error", spec, err)
+		}
+	}
+}
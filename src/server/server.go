@@ -2,43 +2,58 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
-	"net"
+	"net/http"
 	"os"
 	"sync"
 	"time"
 
+	"segment/conntrack"
+	"segment/corestate"
 	"segment/global"
 	"segment/liboutline"
 	"segment/libtun"
 	"segment/libxray"
+	"segment/logstream"
 	"segment/slogger"
 
 	// "segment/middleware"
 	"segment/proxycoreproto"
+	"segment/subscription"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
 var (
-	isVpnMode       bool
-	wg              sync.WaitGroup
-	isServerStarted bool
-	serverError     error
-
-	coreRegistry   = make(map[string]Core)
-	coreLock       sync.RWMutex
-	activeCoreName = libxray.GetXrayService().CoreName()
+	isVpnMode bool
+
+	serverMu      sync.Mutex
+	grpcServer    *grpc.Server
+	serverSpec    string
+	serverAddress string
+	serverClosing chan struct{} // closed when the server starts shutting down
+	serverDone    chan struct{} // closed once Serve has returned
+	serverError   error
+
+	coreRegistry    = make(map[string]Core)
+	coreLock        sync.RWMutex
+	activeCoreName  = libxray.GetXrayService().CoreName()
+	activeProxyPort int32
+	activeProxyAuth *global.ProxyAuth // Credentials of the active core's inbounds
 )
 
 type Core interface {
 	Start(ctx context.Context, opts global.StartOptions) error
+	Reload(ctx context.Context, opts global.StartOptions) error
 	Stop(ctx context.Context) error
 	IsRunning() bool
 	Version() string
 	MeasurePing(ctx context.Context, urls []string) (*proxycoreproto.MeasurePingResponse, error)
+	TrafficStats(reset bool) (*proxycoreproto.TrafficStatsResponse, error)
+	OutboundStatus() (*proxycoreproto.OutboundStatusResponse, error)
 	FetchLogs() string
 	ClearLogs() bool
 	CoreName() string
@@ -47,6 +62,7 @@ type Core interface {
 func init() {
 	registerCore(libxray.GetXrayService())
 	registerCore(liboutline.GetOutlineService())
+	registerCore(liboutline.GetSmartService())
 }
 
 func registerCore(c Core) {
@@ -71,7 +87,24 @@ func getActiveCore() (Core, error) {
 
 type server struct {
 	proxycoreproto.UnimplementedProxyCoreServer
-	logger *slog.Logger
+	logger  *slog.Logger
+	closing <-chan struct{} // nil for the in-process wrappers
+}
+
+// streamContext returns a context for a long-lived stream that is also
+// cancelled when the server shuts down.
+func (s *server) streamContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	if s.closing != nil {
+		go func() {
+			select {
+			case <-s.closing:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+	}
+	return ctx, cancel
 }
 
 func (s *server) StartCore(ctx context.Context, req *proxycoreproto.StartCoreRequest) (*proxycoreproto.Empty, error) {
@@ -87,27 +120,33 @@ func (s *server) StartCore(ctx context.Context, req *proxycoreproto.StartCoreReq
 		return nil, err
 	}
 
+	opts := startOptions(req)
+
 	coreLock.Lock()
 	activeCoreName = req.CoreName
+	activeProxyPort = req.ProxyPort
+	activeProxyAuth = opts.Auth
 	coreLock.Unlock()
 
 	isVpnMode = req.IsVpnMode
 
-	opts := global.StartOptions{
-		Dir:       req.Dir,
-		Config:    req.Config,
-		Memory:    int64(req.Memory),
-		IsString:  req.IsString,
-		ProxyPort: req.ProxyPort,
+	tunOpts := tunOptions(req.Tun, req.Dir)
+	if isVpnMode {
+		if err := tunOpts.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid tun options: %w", err)
+		}
 	}
-
 	if err := core.Start(ctx, opts); err != nil {
 		return nil, fmt.Errorf("failed to start core '%s': %w", req.CoreName, err)
 	}
 	s.logger.Info("Core started")
 
 	if isVpnMode && !libtun.IsStarted() {
-		if err := libtun.Start(int(req.TunFD), fmt.Sprintf("127.0.0.1:%d", req.ProxyPort)); err != nil {
+		if err := libtun.Start(int(req.TunFD), fmt.Sprintf("127.0.0.1:%d", req.ProxyPort), opts.Auth, tunOpts); err != nil {
+			// Leave nothing running, so the start can be retried.
+			if stopErr := core.Stop(ctx); stopErr != nil {
+				s.logger.Error("Failed to stop core after tun2socks failed", "error", stopErr)
+			}
 			return nil, fmt.Errorf("failed to start tun2socks: %w", err)
 		}
 		s.logger.Info("Tun2socks started")
@@ -116,6 +155,119 @@ func (s *server) StartCore(ctx context.Context, req *proxycoreproto.StartCoreReq
 	return &proxycoreproto.Empty{}, nil
 }
 
+// ReloadCore switches the running proxy to a new config, or to another
+// core, on the same SOCKS port. tun2socks is left running throughout.
+func (s *server) ReloadCore(ctx context.Context, req *proxycoreproto.StartCoreRequest) (*proxycoreproto.Empty, error) {
+	core, err := getCore(req.CoreName)
+	if err != nil {
+		return nil, err
+	}
+
+	proxyAddress := fmt.Sprintf("127.0.0.1:%d", req.ProxyPort)
+	if libtun.IsStarted() && libtun.ProxyAddress() != proxyAddress {
+		return nil, fmt.Errorf("tun2socks is bound to %s, cannot reload onto %s", libtun.ProxyAddress(), proxyAddress)
+	}
+	opts := startOptions(req)
+	if libtun.IsStarted() && !sameAuth(libtun.ProxyAuth(), opts.Auth) {
+		return nil, fmt.Errorf("tun2socks logs in to %s with other credentials, cannot reload", proxyAddress)
+	}
+
+	active, err := getActiveCore()
+	if err != nil {
+		return nil, err
+	}
+
+	if active == core && core.IsRunning() {
+		if err := core.Reload(ctx, opts); err != nil {
+			return nil, fmt.Errorf("failed to reload core '%s': %w", req.CoreName, err)
+		}
+		coreLock.Lock()
+		activeProxyPort = req.ProxyPort
+		activeProxyAuth = opts.Auth
+		coreLock.Unlock()
+		s.logger.Info("Core reloaded")
+		return &proxycoreproto.Empty{}, nil
+	}
+
+	if active.IsRunning() {
+		if err := active.Stop(ctx); err != nil {
+			return nil, fmt.Errorf("failed to stop core '%s': %w", active.CoreName(), err)
+		}
+	}
+
+	coreLock.Lock()
+	activeCoreName = req.CoreName
+	activeProxyPort = req.ProxyPort
+	activeProxyAuth = opts.Auth
+	coreLock.Unlock()
+
+	if err := core.Start(ctx, opts); err != nil {
+		return nil, fmt.Errorf("failed to start core '%s': %w", req.CoreName, err)
+	}
+	s.logger.Info("Core switched", "core", req.CoreName)
+
+	return &proxycoreproto.Empty{}, nil
+}
+
+func startOptions(req *proxycoreproto.StartCoreRequest) global.StartOptions {
+	opts := global.StartOptions{
+		Dir:       req.Dir,
+		Config:    req.Config,
+		Memory:    int64(req.Memory),
+		IsString:  req.IsString,
+		ProxyPort: req.ProxyPort,
+		HTTPPort:  req.HttpPort,
+		Mixed:     req.Mixed,
+	}
+	if auth := req.Auth; auth != nil && auth.Username != "" {
+		opts.Auth = &global.ProxyAuth{Username: auth.Username, Password: auth.Password}
+	}
+	if pool := req.Pool; pool != nil {
+		opts.Pool = &global.PoolOptions{
+			Servers:       pool.Servers,
+			Strategy:      pool.Strategy,
+			ProbeURL:      pool.ProbeUrl,
+			ProbeInterval: time.Duration(pool.ProbeIntervalSec) * time.Second,
+		}
+	}
+	return opts
+}
+
+func tunOptions(tun *proxycoreproto.TunOptions, dir string) libtun.Options {
+	if tun == nil {
+		return libtun.Options{}
+	}
+	return libtun.Options{
+		MTU:                      int(tun.Mtu),
+		UDPTimeout:               time.Duration(tun.UdpTimeoutSec) * time.Second,
+		TCPSendBufferSize:        int(tun.TcpSendBufferBytes),
+		TCPReceiveBufferSize:     int(tun.TcpReceiveBufferBytes),
+		TCPModerateReceiveBuffer: tun.TcpModerateReceiveBuffer,
+		LogLevel:                 tun.LogLevel,
+		RestAPI:                  tun.RestApi,
+		MulticastGroups:          tun.MulticastGroups,
+		DNS: libtun.DNSOptions{
+			Upstreams:   tun.Dns.GetUpstreams(),
+			FakeIP:      tun.Dns.GetFakeIp(),
+			FakeIPRange: tun.Dns.GetFakeIpRange(),
+		},
+		Bypass: libtun.BypassOptions{
+			Private:  tun.Bypass.GetPrivate(),
+			CIDRs:    tun.Bypass.GetCidrs(),
+			Domains:  tun.Bypass.GetDomains(),
+			GeoIP:    tun.Bypass.GetGeoip(),
+			AssetDir: dir,
+		},
+	}
+}
+
+func sameAuth(a, b *global.ProxyAuth) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
 func (s *server) StopCore(ctx context.Context, _ *proxycoreproto.Empty) (*proxycoreproto.Empty, error) {
 	core, err := getActiveCore()
 	if err != nil {
@@ -167,6 +319,137 @@ func (s *server) MeasurePing(ctx context.Context, req *proxycoreproto.MeasurePin
 	return core.MeasurePing(ctx, req.Url)
 }
 
+func (s *server) GetTrafficStats(ctx context.Context, req *proxycoreproto.TrafficStatsRequest) (*proxycoreproto.TrafficStatsResponse, error) {
+	core, err := getActiveCore()
+	if err != nil {
+		return nil, err
+	}
+	stats, err := core.TrafficStats(req.ResetCounters)
+	if err != nil {
+		return nil, err
+	}
+	stats.Connections = int32(conntrack.Count(core.CoreName()))
+	return stats, nil
+}
+
+func (s *server) GetOutboundStatus(ctx context.Context, _ *proxycoreproto.Empty) (*proxycoreproto.OutboundStatusResponse, error) {
+	core, err := getActiveCore()
+	if err != nil {
+		return nil, err
+	}
+	return core.OutboundStatus()
+}
+
+func (s *server) GetTunStats(ctx context.Context, _ *proxycoreproto.Empty) (*proxycoreproto.TunStatsResponse, error) {
+	stats, err := libtun.Stats()
+	if err != nil {
+		return nil, err
+	}
+	return &proxycoreproto.TunStatsResponse{
+		PacketsIn:      stats.PacketsIn,
+		PacketsOut:     stats.PacketsOut,
+		BytesIn:        stats.BytesIn,
+		BytesOut:       stats.BytesOut,
+		DroppedPackets: stats.DroppedPackets,
+		TcpSessions:    int32(stats.TCPSessions),
+		UdpSessions:    int32(stats.UDPSessions),
+		UptimeSec:      int64(stats.Uptime.Seconds()),
+	}, nil
+}
+
+func (s *server) ListConnections(ctx context.Context, _ *proxycoreproto.Empty) (*proxycoreproto.ListConnectionsResponse, error) {
+	entries := conntrack.List()
+	resp := &proxycoreproto.ListConnectionsResponse{
+		Connections: make([]*proxycoreproto.Connection, 0, len(entries)),
+	}
+	for _, e := range entries {
+		resp.Connections = append(resp.Connections, &proxycoreproto.Connection{
+			Id:          e.ID,
+			CoreName:    e.Core,
+			Network:     e.Network,
+			Source:      e.Source,
+			Destination: e.Destination,
+			InboundTag:  e.Inbound,
+			OutboundTag: e.OutboundTag(),
+			StartTime:   e.Start.UnixMilli(),
+			Uplink:      e.Uplink(),
+			Downlink:    e.Downlink(),
+		})
+	}
+	return resp, nil
+}
+
+func (s *server) CloseConnection(ctx context.Context, req *proxycoreproto.CloseConnectionRequest) (*proxycoreproto.Empty, error) {
+	if !conntrack.Close(req.Id) {
+		return nil, fmt.Errorf("connection %d not found", req.Id)
+	}
+	return &proxycoreproto.Empty{}, nil
+}
+
+func (s *server) ConvertShareLink(ctx context.Context, req *proxycoreproto.ConvertShareLinkRequest) (*proxycoreproto.ConvertShareLinkResponse, error) {
+	config, name, err := libxray.ConvertShareLink(req.Link, req.ProxyPort)
+	if err != nil {
+		return nil, err
+	}
+	return &proxycoreproto.ConvertShareLinkResponse{Config: config, Name: name}, nil
+}
+
+func (s *server) FetchSubscription(ctx context.Context, req *proxycoreproto.FetchSubscriptionRequest) (*proxycoreproto.FetchSubscriptionResponse, error) {
+	timeout := 15 * time.Second
+	if req.TimeoutMs > 0 {
+		timeout = time.Duration(req.TimeoutMs) * time.Millisecond
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client := &http.Client{Timeout: timeout}
+	if req.ViaCore {
+		core, err := getActiveCore()
+		if err != nil {
+			return nil, err
+		}
+		if !core.IsRunning() {
+			return nil, fmt.Errorf("core '%s' is not running", core.CoreName())
+		}
+		coreLock.RLock()
+		port, auth := activeProxyPort, activeProxyAuth
+		coreLock.RUnlock()
+		client = subscription.ProxyClient(fmt.Sprintf("127.0.0.1:%d", port), auth, timeout)
+	}
+
+	result, err := subscription.Fetch(ctx, client, req.Url, req.UserAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proxycoreproto.FetchSubscriptionResponse{
+		Title:   result.Title,
+		Entries: make([]*proxycoreproto.SubscriptionEntry, 0, len(result.Entries)),
+		Skipped: int32(result.Skipped),
+	}
+	for _, e := range result.Entries {
+		resp.Entries = append(resp.Entries, &proxycoreproto.SubscriptionEntry{
+			Name:     e.Name,
+			Remark:   e.Remark,
+			Protocol: e.Protocol,
+			Address:  e.Address,
+			Port:     int32(e.Port),
+			Link:     e.Link,
+		})
+	}
+	if info := result.Userinfo; info != nil {
+		resp.Userinfo = &proxycoreproto.SubscriptionUserinfo{
+			Upload:   info.Upload,
+			Download: info.Download,
+			Total:    info.Total,
+		}
+		if !info.Expire.IsZero() {
+			resp.Userinfo.Expire = info.Expire.UnixMilli()
+		}
+	}
+	return resp, nil
+}
+
 func (s *server) FetchLogs(ctx context.Context, _ *proxycoreproto.Empty) (*proxycoreproto.LogResponse, error) {
 	core, err := getActiveCore()
 	if err != nil {
@@ -192,6 +475,88 @@ func (s *server) ClearLogs(ctx context.Context, _ *proxycoreproto.Empty) (*proxy
 	return &proxycoreproto.Empty{}, nil
 }
 
+func (s *server) StreamLogs(req *proxycoreproto.StreamLogsRequest, stream proxycoreproto.ProxyCore_StreamLogsServer) error {
+	ctx, cancel := s.streamContext(stream.Context())
+	defer cancel()
+
+	cursor := logstream.Head()
+	if req.IncludeBacklog {
+		cursor = 0
+	}
+
+	for {
+		entries, next, wait := logstream.Since(cursor)
+		for _, e := range entries {
+			if err := stream.Send(&proxycoreproto.LogEntry{
+				Seq:       e.Seq,
+				Timestamp: e.Time.UnixMilli(),
+				Level:     e.Level,
+				CoreName:  e.Core,
+				Message:   e.Message,
+			}); err != nil {
+				return err
+			}
+		}
+		cursor = next
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (s *server) WatchCoreState(_ *proxycoreproto.Empty, stream proxycoreproto.ProxyCore_WatchCoreStateServer) error {
+	ctx, cancel := s.streamContext(stream.Context())
+	defer cancel()
+	return watchCoreState(ctx, stream.Send)
+}
+
+// watchCoreState replays the current state of the active core and tun2socks,
+// then forwards their transitions to send until ctx is done.
+func watchCoreState(ctx context.Context, send func(*proxycoreproto.CoreStateEvent) error) error {
+	forward := func(events []corestate.Event) error {
+		coreLock.RLock()
+		active := activeCoreName
+		coreLock.RUnlock()
+
+		for _, e := range events {
+			if e.Component != active && e.Component != corestate.Tun {
+				continue
+			}
+			if err := send(&proxycoreproto.CoreStateEvent{
+				Component: e.Component,
+				State:     proxycoreproto.CoreState(e.State),
+				Error:     e.Err,
+				Timestamp: e.Time.UnixMilli(),
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	snapshot, cursor := corestate.Snapshot()
+	if err := forward(snapshot); err != nil {
+		return err
+	}
+
+	for {
+		events, next, wait := corestate.Since(cursor)
+		if err := forward(events); err != nil {
+			return err
+		}
+		cursor = next
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
 // -- IOS Delegate Wrappers --
 
 func HandleStartCore(ctx context.Context, req *proxycoreproto.StartCoreRequest) (*proxycoreproto.Empty, error) {
@@ -200,6 +565,12 @@ func HandleStartCore(ctx context.Context, req *proxycoreproto.StartCoreRequest)
 	}))
 	return (&server{logger: l}).StartCore(ctx, req)
 }
+func HandleReloadCore(ctx context.Context, req *proxycoreproto.StartCoreRequest) (*proxycoreproto.Empty, error) {
+	l := slog.New(slogger.NewMultiplatformConsoleHandler(os.Stdout, &slogger.Options{
+		Level: slog.LevelDebug,
+	}))
+	return (&server{logger: l}).ReloadCore(ctx, req)
+}
 func HandleStopCore(ctx context.Context, req *proxycoreproto.Empty) (*proxycoreproto.Empty, error) {
 	l := slog.New(slogger.NewMultiplatformConsoleHandler(os.Stdout, &slogger.Options{
 		Level: slog.LevelDebug,
@@ -215,72 +586,220 @@ func HandleGetVersion(ctx context.Context, req *proxycoreproto.Empty) (*proxycor
 func HandleMeasurePing(ctx context.Context, req *proxycoreproto.MeasurePingRequest) (*proxycoreproto.MeasurePingResponse, error) {
 	return (&server{}).MeasurePing(ctx, req)
 }
+func HandleGetTrafficStats(ctx context.Context, req *proxycoreproto.TrafficStatsRequest) (*proxycoreproto.TrafficStatsResponse, error) {
+	return (&server{}).GetTrafficStats(ctx, req)
+}
+func HandleGetOutboundStatus(ctx context.Context, req *proxycoreproto.Empty) (*proxycoreproto.OutboundStatusResponse, error) {
+	return (&server{}).GetOutboundStatus(ctx, req)
+}
+func HandleGetTunStats(ctx context.Context, req *proxycoreproto.Empty) (*proxycoreproto.TunStatsResponse, error) {
+	return (&server{}).GetTunStats(ctx, req)
+}
+func HandleListConnections(ctx context.Context, req *proxycoreproto.Empty) (*proxycoreproto.ListConnectionsResponse, error) {
+	return (&server{}).ListConnections(ctx, req)
+}
+func HandleCloseConnection(ctx context.Context, req *proxycoreproto.CloseConnectionRequest) (*proxycoreproto.Empty, error) {
+	return (&server{}).CloseConnection(ctx, req)
+}
+func HandleConvertShareLink(ctx context.Context, req *proxycoreproto.ConvertShareLinkRequest) (*proxycoreproto.ConvertShareLinkResponse, error) {
+	return (&server{}).ConvertShareLink(ctx, req)
+}
+func HandleFetchSubscription(ctx context.Context, req *proxycoreproto.FetchSubscriptionRequest) (*proxycoreproto.FetchSubscriptionResponse, error) {
+	return (&server{}).FetchSubscription(ctx, req)
+}
+func HandleTestConfigs(ctx context.Context, req *proxycoreproto.TestConfigsRequest, send func(*proxycoreproto.TestConfigResult) error) error {
+	return testConfigs(ctx, req, send)
+}
 func HandleFetchLogs(ctx context.Context, req *proxycoreproto.Empty) (*proxycoreproto.LogResponse, error) {
 	return (&server{}).FetchLogs(ctx, req)
 }
 func HandleClearLogs(ctx context.Context, req *proxycoreproto.Empty) (*proxycoreproto.Empty, error) {
 	return (&server{}).ClearLogs(ctx, req)
 }
+func HandleWatchCoreState(ctx context.Context, send func(*proxycoreproto.CoreStateEvent) error) error {
+	return watchCoreState(ctx, send)
+}
 
 // -- GRPC Server Boot --
 
-func waitForServer() {
-	wg.Wait()
-}
+// DefaultShutdownTimeout is how long the exported wrappers let in-flight
+// calls finish when stopping the gRPC server.
+const DefaultShutdownTimeout = 5 * time.Second
 
+// WaitForServer blocks until the gRPC server is stopped. A restart in the
+// meantime keeps it waiting on the new server.
 func WaitForServer() {
-	waitForServer()
+	for {
+		serverMu.Lock()
+		done := serverDone
+		serverMu.Unlock()
+
+		if done == nil {
+			return
+		}
+		<-done
+	}
 }
 
-func startGRPCServer(l *slog.Logger) error {
-	if isServerStarted {
-		l.Info("gRPC server already running")
-		return nil
+func startGRPCServer(l *slog.Logger, spec string) (string, error) {
+	token, err := newAuthToken()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate auth token: %w", err)
 	}
-	isServerStarted = true
 
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
+	lis, addr, err := listen(spec)
+	if err != nil {
+		l.Error("failed to listen", slog.Any("error", err))
+		serverError = err
+		return "", err
+	}
 
-		// TODO: pick a port that isn't in this list:
-		// /proc/sys/net/ipv4/ip_local_unbindable_ports
-		// Or migrate to using unix domain sockets
-		lis, err := net.Listen("tcp", "127.0.0.1:30051")
-		if err != nil {
-			l.Error("failed to listen", slog.Any("error", err))
-			serverError = err
-			isServerStarted = false
-			return
-		}
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(unaryAuthInterceptor(token)),
+		grpc.StreamInterceptor(streamAuthInterceptor(token)),
+	)
+	closing := make(chan struct{})
+	proxycoreproto.RegisterProxyCoreServer(s, &server{logger: l, closing: closing})
+	reflection.Register(s)
+
+	done := make(chan struct{})
+	grpcServer = s
+	serverSpec = spec
+	serverAddress = addr
+	serverClosing = closing
+	serverDone = done
+	authToken = token
 
-		grpcServer := grpc.NewServer()
-		proxycoreproto.RegisterProxyCoreServer(grpcServer, &server{logger: l})
-		reflection.Register(grpcServer)
+	go func() {
+		defer close(done)
 
-		if err := grpcServer.Serve(lis); err != nil {
+		l.Info("gRPC server listening at", slog.String("address", addr))
+		if err := s.Serve(lis); err != nil {
 			l.Error("gRPC serve failed", slog.Any("error", err))
+
+			serverMu.Lock()
 			serverError = err
-			isServerStarted = false
+			if grpcServer == s {
+				resetServerState()
+			}
+			serverMu.Unlock()
 		}
-		l.Info("gRPC server listening at", slog.Any("address", lis.Addr()))
 	}()
 
-	return nil
+	return addr, nil
 }
 
-func StartGRPCServer() bool {
+// StartGRPCServer starts the gRPC server on the given listen spec (see listen)
+// and returns the address it is reachable at together with the session token
+// clients must send in the AuthMetadataKey header of every call. An empty
+// spec uses DefaultListenAddress. Calling it again returns the running
+// server's address and token.
+func StartGRPCServer(spec string) (addr, token string, err error) {
 	l := slog.New(slogger.NewMultiplatformConsoleHandler(os.Stdout, &slogger.Options{
 		Level: slog.LevelDebug,
 	}))
 
-	if !isServerStarted {
-		l.Info("Starting gRPC server")
-		if err := startGRPCServer(l); err != nil {
-			l.Error("Failed to start gRPC server", slog.Any("error", err))
-			return false
+	serverMu.Lock()
+	defer serverMu.Unlock()
+
+	if grpcServer != nil {
+		l.Info("gRPC server already running", slog.String("address", serverAddress))
+		return serverAddress, authToken, nil
+	}
+
+	l.Info("Starting gRPC server")
+	if addr, err = startGRPCServer(l, spec); err != nil {
+		l.Error("Failed to start gRPC server", slog.Any("error", err))
+		return "", "", err
+	}
+	return addr, authToken, nil
+}
+
+// StopGRPCServer stops the running core and tun2socks, then shuts the gRPC
+// server down. In-flight calls get until timeout to finish before they are
+// cancelled. It is a no-op when the server is not running.
+func StopGRPCServer(timeout time.Duration) error {
+	l := slog.New(slogger.NewMultiplatformConsoleHandler(os.Stdout, &slogger.Options{
+		Level: slog.LevelDebug,
+	}))
+
+	serverMu.Lock()
+	defer serverMu.Unlock()
+
+	if grpcServer == nil {
+		return nil
+	}
+	l.Info("Stopping gRPC server")
+
+	// Streams never end on their own, let them return before GracefulStop.
+	close(serverClosing)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	stopErr := stopCoreAndTun(ctx, l)
+	if stopErr != nil {
+		l.Error("Failed to stop core", slog.Any("error", stopErr))
+	}
+
+	stopped := make(chan struct{})
+	go func(s *grpc.Server) {
+		s.GracefulStop()
+		close(stopped)
+	}(grpcServer)
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		l.Warn("gRPC graceful stop timed out, forcing")
+		grpcServer.Stop()
+		<-stopped
+	}
+
+	resetServerState()
+
+	l.Info("gRPC server stopped")
+	return stopErr
+}
+
+// RestartGRPCServer stops the server like StopGRPCServer and starts it again
+// on the same listen spec, returning the new address and auth token. The
+// server is restarted even when the core fails to stop; that error is
+// returned along with the new address.
+func RestartGRPCServer(timeout time.Duration) (addr, token string, err error) {
+	serverMu.Lock()
+	spec := serverSpec
+	serverMu.Unlock()
+
+	stopErr := StopGRPCServer(timeout)
+	if addr, token, err = StartGRPCServer(spec); err != nil {
+		return "", "", errors.Join(stopErr, err)
+	}
+	return addr, token, stopErr
+}
+
+// stopCoreAndTun stops whatever core is active and tun2socks, even when the
+// core already stopped on its own.
+func stopCoreAndTun(ctx context.Context, l *slog.Logger) error {
+	var stopErr error
+	if core, err := getActiveCore(); err == nil && core.IsRunning() {
+		if err := core.Stop(ctx); err != nil {
+			stopErr = fmt.Errorf("failed to stop core: %w", err)
+		} else {
+			l.Info("Core stopped")
 		}
-		go waitForServer()
 	}
-	return isServerStarted
+	if libtun.IsStarted() {
+		libtun.Stop()
+		l.Info("Tun2socks stopped")
+	}
+	return stopErr
+}
+
+// resetServerState must be called with serverMu held.
+func resetServerState() {
+	grpcServer = nil
+	serverAddress = ""
+	serverClosing = nil
+	serverDone = nil
+	authToken = ""
 }
@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"segment/latency"
+	"segment/liboutline"
+	"segment/libxray"
+	"segment/proxycoreproto"
+)
+
+const (
+	defaultTestConcurrency = 8
+	maxTestConcurrency     = 32
+)
+
+func (s *server) TestConfigs(req *proxycoreproto.TestConfigsRequest, stream proxycoreproto.ProxyCore_TestConfigsServer) error {
+	ctx, cancel := s.streamContext(stream.Context())
+	defer cancel()
+	return testConfigs(ctx, req, stream.Send)
+}
+
+// testConfigs measures every config on its own ephemeral dialer and sends
+// each result as soon as it is ready. The active core is not involved.
+func testConfigs(ctx context.Context, req *proxycoreproto.TestConfigsRequest, send func(*proxycoreproto.TestConfigResult) error) error {
+	concurrency := int(req.Concurrency)
+	if concurrency <= 0 {
+		concurrency = defaultTestConcurrency
+	}
+	concurrency = min(concurrency, maxTestConcurrency)
+
+	timeout := latency.DefaultTimeout
+	if req.TimeoutMs > 0 {
+		timeout = time.Duration(req.TimeoutMs) * time.Millisecond
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg      sync.WaitGroup
+		sendMu  sync.Mutex
+		sendErr error
+		sem     = make(chan struct{}, concurrency)
+	)
+	for i, cfg := range req.Configs {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(index int, cfg *proxycoreproto.TestConfig) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			result := testConfig(ctx, cfg, req.Url, timeout)
+			result.Index = int32(index)
+
+			sendMu.Lock()
+			defer sendMu.Unlock()
+			if sendErr != nil {
+				return
+			}
+			if sendErr = send(result); sendErr != nil {
+				cancel()
+			}
+		}(i, cfg)
+	}
+	wg.Wait()
+
+	return sendErr
+}
+
+func testConfig(ctx context.Context, cfg *proxycoreproto.TestConfig, url string, timeout time.Duration) *proxycoreproto.TestConfigResult {
+	coreName := cfg.CoreName
+	if coreName == "" {
+		coreName = detectCoreName(cfg.Config)
+	}
+	result := &proxycoreproto.TestConfigResult{Id: cfg.Id, CoreName: coreName, Delay: -1}
+
+	var (
+		dial    latency.DialFunc
+		release func() error
+		err     error
+	)
+	switch coreName {
+	case libxray.GetXrayService().CoreName():
+		dial, release, err = libxray.NewTestDialer(cfg.Config)
+	case liboutline.GetOutlineService().CoreName():
+		dial, err = liboutline.NewTestDialer(ctx, cfg.Config)
+	case liboutline.GetSmartService().CoreName():
+		err = fmt.Errorf("core '%s' does not support config tests", coreName)
+	default:
+		err = fmt.Errorf("core '%s' not registered", coreName)
+	}
+	if err != nil {
+		result.Error = err.Error()
+		result.ErrorCategory = proxycoreproto.PingErrorCategory_PING_ERROR_OTHER
+		return result
+	}
+	if release != nil {
+		defer release()
+	}
+
+	res := latency.Measure(ctx, dial, url, timeout)
+	result.ConnectDelay = res.Connect.Milliseconds()
+	result.TlsDelay = res.TLS.Milliseconds()
+	result.HttpDelay = res.HTTP.Milliseconds()
+	result.StatusCode = int32(res.Status)
+	result.ErrorCategory = res.Category
+	if res.Err != nil {
+		result.Error = res.Err.Error()
+		return result
+	}
+	result.Delay = res.Total.Milliseconds()
+	return result
+}
+
+// detectCoreName tells Outline's SSConfig and dynamic keys apart from Xray
+// JSON and share links.
+func detectCoreName(config string) string {
+	if strings.HasPrefix(strings.TrimSpace(config), "ssconf://") {
+		return liboutline.GetOutlineService().CoreName()
+	}
+	if strings.HasPrefix(strings.TrimSpace(config), "{") {
+		var probe struct {
+			Outbounds json.RawMessage `json:"outbounds"`
+			Server    string          `json:"server"`
+			Method    string          `json:"method"`
+			Transport string          `json:"transport"`
+		}
+		if json.Unmarshal([]byte(config), &probe) == nil &&
+			probe.Outbounds == nil && (probe.Server != "" && probe.Method != "" || probe.Transport != "") {
+			return liboutline.GetOutlineService().CoreName()
+		}
+	}
+	return libxray.GetXrayService().CoreName()
+}
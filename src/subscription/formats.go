@@ -0,0 +1,250 @@
+package subscription
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// sip008 is the Shadowsocks SIP008 online config document.
+type sip008 struct {
+	Version int `json:"version"`
+	Servers []struct {
+		Remarks    string `json:"remarks"`
+		Server     string `json:"server"`
+		ServerPort int    `json:"server_port"`
+		Password   string `json:"password"`
+		Method     string `json:"method"`
+		Plugin     string `json:"plugin"`
+	} `json:"servers"`
+}
+
+func decodeSIP008(body []byte) ([]link, error) {
+	var doc sip008
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, fmt.Errorf("invalid SIP008 document: %w", err)
+	}
+	if doc.Servers == nil {
+		return nil, errors.New("invalid SIP008 document: no servers")
+	}
+
+	links := make([]link, 0, len(doc.Servers))
+	for _, s := range doc.Servers {
+		if s.Plugin != "" {
+			links = append(links, link{err: errors.New("shadowsocks plugins are not supported")})
+			continue
+		}
+		links = append(links, link{
+			uri:    shadowsocksLink(s.Method, s.Password, s.Server, s.ServerPort, s.Remarks),
+			remark: s.Remarks,
+		})
+	}
+	return links, nil
+}
+
+func shadowsocksLink(method, password, server string, port int, name string) string {
+	u := url.URL{
+		Scheme:   "ss",
+		User:     url.User(base64.RawURLEncoding.EncodeToString([]byte(method + ":" + password))),
+		Host:     net.JoinHostPort(server, strconv.Itoa(port)),
+		Fragment: name,
+	}
+	return u.String()
+}
+
+// isClash reports whether body looks like a Clash config with a proxy list.
+func isClash(body []byte) bool {
+	return bytes.HasPrefix(body, []byte("proxies:")) || bytes.Contains(body, []byte("\nproxies:"))
+}
+
+type clashConfig struct {
+	Proxies []map[string]any `yaml:"proxies"`
+}
+
+func decodeClash(body []byte) ([]link, error) {
+	var cfg clashConfig
+	if err := yaml.Unmarshal(body, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid Clash config: %w", err)
+	}
+
+	links := make([]link, 0, len(cfg.Proxies))
+	for _, p := range cfg.Proxies {
+		uri, err := clashLink(clashProxy(p))
+		links = append(links, link{uri: uri, remark: clashProxy(p).str("name"), err: err})
+	}
+	return links, nil
+}
+
+// clashProxy is one entry of the Clash "proxies" list.
+type clashProxy map[string]any
+
+func (p clashProxy) str(key string) string {
+	switch v := p[key].(type) {
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return ""
+}
+
+func (p clashProxy) bool(key string) bool {
+	v, _ := p[key].(bool)
+	return v
+}
+
+func (p clashProxy) sub(key string) clashProxy {
+	switch v := p[key].(type) {
+	case map[any]any:
+		m := make(clashProxy, len(v))
+		for k, val := range v {
+			m[fmt.Sprint(k)] = val
+		}
+		return m
+	case map[string]any:
+		return v
+	}
+	return clashProxy{}
+}
+
+// clashLink converts a Clash proxy into the equivalent share link.
+func clashLink(p clashProxy) (string, error) {
+	server, name := p.str("server"), p.str("name")
+	port, err := strconv.Atoi(p.str("port"))
+	if server == "" || err != nil {
+		return "", fmt.Errorf("clash proxy %q has no valid server", name)
+	}
+	host := net.JoinHostPort(server, strconv.Itoa(port))
+
+	switch typ := p.str("type"); typ {
+	case "ss":
+		if p.str("plugin") != "" {
+			return "", errors.New("shadowsocks plugins are not supported")
+		}
+		return shadowsocksLink(p.str("cipher"), p.str("password"), server, port, name), nil
+	case "vmess":
+		return vmessLink(p, server, port)
+	case "vless", "trojan":
+		q, err := clashTransport(p)
+		if err != nil {
+			return "", err
+		}
+		u := url.URL{Scheme: typ, Host: host, Fragment: name}
+		switch typ {
+		case "vless":
+			u.User = url.User(p.str("uuid"))
+			q.Set("encryption", "none")
+			if flow := p.str("flow"); flow != "" {
+				q.Set("flow", flow)
+			}
+		case "trojan":
+			u.User = url.User(p.str("password"))
+			if q.Get("security") == "" {
+				q.Set("security", "tls")
+			}
+		}
+		u.RawQuery = q.Encode()
+		return u.String(), nil
+	default:
+		return "", fmt.Errorf("clash proxy type %q is not supported", typ)
+	}
+}
+
+// clashTransport maps Clash transport and TLS options onto share-link query parameters.
+func clashTransport(p clashProxy) (url.Values, error) {
+	q := url.Values{}
+	network := p.str("network")
+	if network == "" {
+		network = "tcp"
+	}
+	q.Set("type", network)
+
+	switch network {
+	case "ws":
+		ws := p.sub("ws-opts")
+		q.Set("path", ws.str("path"))
+		q.Set("host", ws.sub("headers").str("Host"))
+	case "grpc":
+		q.Set("serviceName", p.sub("grpc-opts").str("grpc-service-name"))
+	case "http":
+		// HTTP header obfuscation over TCP. Its path is a list, the first one is used.
+		if paths, ok := p.sub("http-opts")["path"].([]any); ok && len(paths) > 0 {
+			q.Set("path", fmt.Sprint(paths[0]))
+		}
+		q.Set("type", "tcp")
+		q.Set("headerType", "http")
+	case "h2":
+		return nil, errors.New("clash h2 transport is not supported")
+	}
+
+	sni := p.str("servername")
+	if sni == "" {
+		sni = p.str("sni")
+	}
+	if reality := p.sub("reality-opts"); len(reality) > 0 {
+		q.Set("security", "reality")
+		q.Set("pbk", reality.str("public-key"))
+		q.Set("sid", reality.str("short-id"))
+	} else if p.bool("tls") {
+		q.Set("security", "tls")
+	}
+	if sni != "" {
+		q.Set("sni", sni)
+	}
+	if fp := p.str("client-fingerprint"); fp != "" {
+		q.Set("fp", fp)
+	}
+	if alpn, ok := p["alpn"].([]any); ok && len(alpn) > 0 {
+		parts := make([]string, len(alpn))
+		for i, a := range alpn {
+			parts[i] = fmt.Sprint(a)
+		}
+		q.Set("alpn", strings.Join(parts, ","))
+	}
+	if p.bool("skip-cert-verify") {
+		q.Set("allowInsecure", "1")
+	}
+	return q, nil
+}
+
+// vmessLink builds a v2rayN style vmess link.
+func vmessLink(p clashProxy, server string, port int) (string, error) {
+	q, err := clashTransport(p)
+	if err != nil {
+		return "", err
+	}
+	v := map[string]any{
+		"v":    "2",
+		"ps":   p.str("name"),
+		"add":  server,
+		"port": strconv.Itoa(port),
+		"id":   p.str("uuid"),
+		"aid":  p.str("alterId"),
+		"scy":  p.str("cipher"),
+		"net":  q.Get("type"),
+		"type": q.Get("headerType"),
+		"host": q.Get("host"),
+		"path": q.Get("path"),
+		"tls":  q.Get("security"),
+		"sni":  q.Get("sni"),
+		"alpn": q.Get("alpn"),
+		"fp":   q.Get("fp"),
+	}
+	if v["net"] == "grpc" {
+		v["path"] = q.Get("serviceName")
+	}
+	raw, _ := json.Marshal(v)
+	return "vmess://" + base64.StdEncoding.EncodeToString(raw), nil
+}
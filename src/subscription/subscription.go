@@ -0,0 +1,258 @@
+package subscription
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"segment/global"
+	"segment/libxray"
+)
+
+// DefaultUserAgent is sent when the caller does not pick one. Most panels
+// answer it with a base64 link list.
+const DefaultUserAgent = "v2rayNG/1.9.0"
+
+// maxBodySize caps how much of a subscription is read.
+const maxBodySize = 8 << 20
+
+// Entry is one server of a subscription, normalized to a share link.
+type Entry struct {
+	Name     string // Display name, falls back to address:port
+	Remark   string // Remark as given by the provider, may be empty
+	Protocol string
+	Address  string
+	Port     int
+	Link     string // Share link accepted by libxray.ConvertShareLink
+}
+
+// Userinfo is the quota reported in the Subscription-Userinfo header.
+type Userinfo struct {
+	Upload   int64
+	Download int64
+	Total    int64
+	Expire   time.Time // Zero when the subscription does not expire
+}
+
+// Result is a decoded subscription.
+type Result struct {
+	Title    string
+	Entries  []Entry
+	Userinfo *Userinfo // nil when the provider sent no header
+	Skipped  int       // Entries that could not be decoded
+}
+
+// Fetch downloads rawURL with client and decodes it. A nil client uses
+// http.DefaultClient.
+func Fetch(ctx context.Context, client *http.Client, rawURL, userAgent string) (*Result, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if userAgent == "" {
+		userAgent = DefaultUserAgent
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subscription url: %w", err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch subscription: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch subscription: unexpected status %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		return nil, fmt.Errorf("read subscription: %w", err)
+	}
+
+	result, err := Decode(body)
+	if err != nil {
+		return nil, err
+	}
+	result.Userinfo = ParseUserinfo(resp.Header.Get("Subscription-Userinfo"))
+	result.Title = decodeTitle(resp.Header.Get("Profile-Title"))
+	return result, nil
+}
+
+// ProxyClient returns an HTTP client that fetches through the SOCKS
+// inbound of a running core, logging in with auth when it is not nil.
+func ProxyClient(proxyAddress string, auth *global.ProxyAuth, timeout time.Duration) *http.Client {
+	proxy := &url.URL{Scheme: "socks5", Host: proxyAddress}
+	if auth != nil {
+		proxy.User = url.UserPassword(auth.Username, auth.Password)
+	}
+	dialer := &net.Dialer{Timeout: timeout}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:               http.ProxyURL(proxy),
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			DisableKeepAlives:   true,
+		},
+	}
+}
+
+// Decode detects the subscription format and decodes every entry.
+func Decode(body []byte) (*Result, error) {
+	body = bytes.TrimSpace(bytes.TrimPrefix(body, []byte("\xef\xbb\xbf")))
+	if len(body) == 0 {
+		return nil, errors.New("subscription is empty")
+	}
+
+	var (
+		links []link
+		err   error
+	)
+	switch {
+	case body[0] == '{':
+		links, err = decodeSIP008(body)
+	case isClash(body):
+		links, err = decodeClash(body)
+	default:
+		if !bytes.Contains(body, []byte("://")) {
+			decoded, derr := global.DecodeBase64(string(body))
+			if derr != nil {
+				return nil, errors.New("unrecognized subscription format")
+			}
+			body = decoded
+		}
+		links = decodeLinkList(body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	for _, l := range links {
+		if l.err != nil {
+			result.Skipped++
+			continue
+		}
+		sl, err := libxray.ParseShareLink(l.uri)
+		if err == nil {
+			err = sl.Validate()
+		}
+		if err != nil {
+			result.Skipped++
+			continue
+		}
+		remark := l.remark
+		if remark == "" {
+			remark = sl.Name
+		}
+		name := remark
+		if name == "" {
+			name = net.JoinHostPort(sl.Address, strconv.Itoa(sl.Port))
+		}
+		result.Entries = append(result.Entries, Entry{
+			Name:     name,
+			Remark:   remark,
+			Protocol: sl.Protocol,
+			Address:  sl.Address,
+			Port:     sl.Port,
+			Link:     l.uri,
+		})
+	}
+
+	if len(result.Entries) == 0 {
+		return nil, fmt.Errorf("subscription has no usable servers (%d skipped)", result.Skipped)
+	}
+	return result, nil
+}
+
+// link is an entry converted to share-link form, or the reason it could not be.
+type link struct {
+	uri    string
+	remark string
+	err    error
+}
+
+func decodeLinkList(body []byte) []link {
+	var links []link
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	scanner.Buffer(make([]byte, 64*1024), maxBodySize)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "//") {
+			continue
+		}
+		if !strings.Contains(line, "://") {
+			links = append(links, link{err: errors.New("not a share link")})
+			continue
+		}
+		links = append(links, link{uri: line})
+	}
+	return links
+}
+
+// ParseUserinfo parses "upload=1; download=2; total=3; expire=1700000000".
+// It returns nil when the header is empty or has none of the known keys.
+func ParseUserinfo(header string) *Userinfo {
+	var (
+		info  Userinfo
+		found bool
+	)
+	for _, field := range strings.Split(header, ";") {
+		key, value, ok := strings.Cut(strings.TrimSpace(field), "=")
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			// Some panels send floats
+			f, ferr := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if ferr != nil {
+				continue
+			}
+			n = int64(f)
+		}
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "upload":
+			info.Upload = n
+		case "download":
+			info.Download = n
+		case "total":
+			info.Total = n
+		case "expire":
+			if n > 0 {
+				info.Expire = time.Unix(n, 0)
+			}
+		default:
+			continue
+		}
+		found = true
+	}
+	if !found {
+		return nil
+	}
+	return &info
+}
+
+// decodeTitle handles the optional "base64:" prefix panels use for
+// non-ASCII titles.
+func decodeTitle(title string) string {
+	if encoded, ok := strings.CutPrefix(title, "base64:"); ok {
+		if decoded, err := global.DecodeBase64(encoded); err == nil {
+			return string(decoded)
+		}
+	}
+	return title
+}
@@ -0,0 +1,327 @@
+package subscription
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+const testUUID = "b831381d-6324-4d53-ad4f-8cda48b30811"
+
+// serve starts a server answering every request with body and headers.
+func serve(t *testing.T, status int, body string, headers map[string]string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("User-Agent"); got != DefaultUserAgent {
+			t.Errorf("User-Agent = %q, want %q", got, DefaultUserAgent)
+		}
+		for k, v := range headers {
+			w.Header().Set(k, v)
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// entry is the part of an Entry the tests compare.
+type entry struct {
+	Name     string
+	Protocol string
+	Address  string
+	Port     int
+}
+
+func entries(r *Result) []entry {
+	out := make([]entry, len(r.Entries))
+	for i, e := range r.Entries {
+		out[i] = entry{e.Name, e.Protocol, e.Address, e.Port}
+	}
+	return out
+}
+
+// wrap breaks s into 76 character lines like MIME base64.
+func wrap(s string) string {
+	var b strings.Builder
+	for len(s) > 76 {
+		b.WriteString(s[:76] + "\r\n")
+		s = s[76:]
+	}
+	b.WriteString(s)
+	return b.String()
+}
+
+const clashYAML = `port: 7890
+mode: rule
+proxies:
+  - name: SS
+    type: ss
+    server: ss.example.com
+    port: 8388
+    cipher: aes-256-gcm
+    password: secret
+  - name: VMess WS
+    type: vmess
+    server: vm.example.com
+    port: 443
+    uuid: ` + testUUID + `
+    alterId: 0
+    cipher: auto
+    tls: true
+    network: ws
+    ws-opts:
+      path: /vm
+      headers:
+        Host: cdn.example.com
+  - name: Reality
+    type: vless
+    server: 1.2.3.4
+    port: 443
+    uuid: ` + testUUID + `
+    flow: xtls-rprx-vision
+    servername: www.microsoft.com
+    client-fingerprint: chrome
+    reality-opts:
+      public-key: PUBKEY
+      short-id: ab12
+  - name: Trojan gRPC
+    type: trojan
+    server: tr.example.com
+    port: 443
+    password: secret
+    network: grpc
+    grpc-opts:
+      grpc-service-name: svc
+  - name: VLESS HTTP
+    type: vless
+    server: http.example.com
+    port: 80
+    uuid: ` + testUUID + `
+    network: http
+    http-opts:
+      path: [/obfs]
+  - name: VMess H2
+    type: vmess
+    server: h2.example.com
+    port: 443
+    uuid: ` + testUUID + `
+    cipher: auto
+    tls: true
+    network: h2
+    h2-opts:
+      host: [h2.example.com]
+      path: /h2
+  - name: Hysteria2
+    type: hysteria2
+    server: hy.example.com
+    port: 443
+    password: secret
+  - name: Obfs
+    type: ss
+    server: obfs.example.com
+    port: 8388
+    cipher: aes-256-gcm
+    password: secret
+    plugin: obfs
+  - name: WireGuard
+    type: wireguard
+    server: wg.example.com
+    port: 51820
+rules:
+  - MATCH,DIRECT
+`
+
+func TestFetch(t *testing.T) {
+	linkList := strings.Join([]string{
+		"vless://" + testUUID + "@vl.example.com:443?security=tls&type=ws&path=%2Fws#VLESS",
+		"trojan://secret@tr.example.com:443#Trojan",
+		"ss://" + base64.RawURLEncoding.EncodeToString([]byte("aes-128-gcm:secret")) + "@10.0.0.1:8388",
+		"hysteria2://secret@hy.example.com:443#Hysteria2",
+		"vless://" + testUUID + "@vl.example.com:443?type=quic#Unknown transport",
+		"not a link",
+		"# comment",
+		"",
+	}, "\n")
+
+	tests := []struct {
+		name    string
+		body    string
+		headers map[string]string
+		want    []entry
+		skipped int
+		title   string
+	}{
+		{
+			name: "base64 link list",
+			body: wrap(base64.StdEncoding.EncodeToString([]byte(linkList))),
+			want: []entry{
+				{"VLESS", "vless", "vl.example.com", 443},
+				{"Trojan", "trojan", "tr.example.com", 443},
+				{"10.0.0.1:8388", "shadowsocks", "10.0.0.1", 8388},
+			},
+			skipped: 3,
+		},
+		{
+			name: "plain link list",
+			body: "\xef\xbb\xbf" + linkList,
+			want: []entry{
+				{"VLESS", "vless", "vl.example.com", 443},
+				{"Trojan", "trojan", "tr.example.com", 443},
+				{"10.0.0.1:8388", "shadowsocks", "10.0.0.1", 8388},
+			},
+			skipped: 3,
+		},
+		{
+			name: "sip008",
+			body: `{"version": 1, "servers": [
+				{"remarks": "Tokyo", "server": "jp.example.com", "server_port": 8388, "password": "secret", "method": "chacha20-ietf-poly1305"},
+				{"server": "2001:db8::1", "server_port": 443, "password": "p@ss:word", "method": "aes-256-gcm"},
+				{"remarks": "Plugin", "server": "obfs.example.com", "server_port": 8388, "password": "secret", "method": "aes-256-gcm", "plugin": "obfs-local"}
+			]}`,
+			want: []entry{
+				{"Tokyo", "shadowsocks", "jp.example.com", 8388},
+				{"[2001:db8::1]:443", "shadowsocks", "2001:db8::1", 443},
+			},
+			skipped: 1,
+		},
+		{
+			name: "clash",
+			body: clashYAML,
+			want: []entry{
+				{"SS", "shadowsocks", "ss.example.com", 8388},
+				{"VMess WS", "vmess", "vm.example.com", 443},
+				{"Reality", "vless", "1.2.3.4", 443},
+				{"Trojan gRPC", "trojan", "tr.example.com", 443},
+				{"VLESS HTTP", "vless", "http.example.com", 80},
+			},
+			skipped: 4,
+		},
+		{
+			name: "title",
+			body: "trojan://secret@tr.example.com:443#Trojan",
+			headers: map[string]string{
+				"Profile-Title": "base64:" + base64.StdEncoding.EncodeToString([]byte("Мой VPN")),
+			},
+			want:  []entry{{"Trojan", "trojan", "tr.example.com", 443}},
+			title: "Мой VPN",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := serve(t, http.StatusOK, tt.body, tt.headers)
+			result, err := Fetch(context.Background(), srv.Client(), srv.URL, "")
+			if err != nil {
+				t.Fatalf("Fetch: %v", err)
+			}
+			if got := entries(result); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("entries = %+v, want %+v", got, tt.want)
+			}
+			if result.Skipped != tt.skipped {
+				t.Errorf("skipped = %d, want %d", result.Skipped, tt.skipped)
+			}
+			if result.Title != tt.title {
+				t.Errorf("title = %q, want %q", result.Title, tt.title)
+			}
+			if result.Userinfo != nil {
+				t.Errorf("userinfo = %+v without a header", result.Userinfo)
+			}
+		})
+	}
+}
+
+func TestFetchUserinfo(t *testing.T) {
+	srv := serve(t, http.StatusOK, "trojan://secret@tr.example.com:443", map[string]string{
+		"Subscription-Userinfo": "upload=1024; download=2048; total=10737418240; expire=1700000000",
+	})
+	result, err := Fetch(context.Background(), srv.Client(), srv.URL, "")
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	want := &Userinfo{Upload: 1024, Download: 2048, Total: 10737418240, Expire: time.Unix(1700000000, 0)}
+	if !reflect.DeepEqual(result.Userinfo, want) {
+		t.Fatalf("userinfo = %+v, want %+v", result.Userinfo, want)
+	}
+}
+
+func TestFetchErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   string
+	}{
+		{"status", http.StatusForbidden, "trojan://secret@tr.example.com:443", "unexpected status"},
+		{"empty", http.StatusOK, " \n", "subscription is empty"},
+		{"not base64", http.StatusOK, "<html>blocked</html>", "unrecognized subscription format"},
+		{"nothing usable", http.StatusOK, "hysteria2://secret@hy.example.com:443", "no usable servers (1 skipped)"},
+		{"bad sip008", http.StatusOK, `{"version": 1}`, "no servers"},
+		{"bad clash", http.StatusOK, "proxies: [", "invalid Clash config"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := serve(t, tt.status, tt.body, nil)
+			_, err := Fetch(context.Background(), srv.Client(), srv.URL, "")
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseUserinfo(t *testing.T) {
+	tests := []struct {
+		header string
+		want   *Userinfo
+	}{
+		{"", nil},
+		{"foo=1; bar", nil},
+		{"upload=1; download=2; total=3; expire=0", &Userinfo{Upload: 1, Download: 2, Total: 3}},
+		{"Upload = 1 ;DOWNLOAD=2", &Userinfo{Upload: 1, Download: 2}},
+		{"upload=1.5e3; download=x; total=1e10", &Userinfo{Upload: 1500, Total: 10000000000}},
+		{"total=100; expire=1700000000; plan=pro", &Userinfo{Total: 100, Expire: time.Unix(1700000000, 0)}},
+	}
+
+	for _, tt := range tests {
+		if got := ParseUserinfo(tt.header); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseUserinfo(%q) = %+v, want %+v", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestClashTransport(t *testing.T) {
+	tests := []struct {
+		proxy string
+		want  string // Link query, empty when the proxy is rejected
+	}{
+		{"{network: http, http-opts: {path: [/a, /b]}}", "headerType=http&path=%2Fa&type=tcp"},
+		{"{network: h2, tls: true, h2-opts: {path: /h2}}", ""},
+		{"{network: grpc, grpc-opts: {grpc-service-name: svc}}", "serviceName=svc&type=grpc"},
+	}
+
+	for _, tt := range tests {
+		var p clashProxy
+		if err := yaml.Unmarshal([]byte(tt.proxy), &p); err != nil {
+			t.Fatal(err)
+		}
+		q, err := clashTransport(p)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("%s: got %q, want an error", tt.proxy, q.Encode())
+			}
+			continue
+		}
+		if err != nil || q.Encode() != tt.want {
+			t.Errorf("%s: got %q, %v, want %q", tt.proxy, q.Encode(), err, tt.want)
+		}
+	}
+}
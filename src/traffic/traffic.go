@@ -0,0 +1,94 @@
+package traffic
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"segment/proxycoreproto"
+)
+
+// Sampler turns monotonically growing byte totals into per-second rates.
+// Rates are averaged over the time elapsed since the previous sample.
+type Sampler struct {
+	mu       sync.Mutex
+	lastUp   int64
+	lastDown int64
+	lastAt   time.Time
+}
+
+// Sample builds a stats response from the given totals. When reset is true
+// the caller is expected to have zeroed its counters after reading them.
+func (s *Sampler) Sample(up, down int64, reset bool) *proxycoreproto.TrafficStatsResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	resp := &proxycoreproto.TrafficStatsResponse{Uplink: up, Downlink: down}
+
+	if !s.lastAt.IsZero() {
+		if elapsed := now.Sub(s.lastAt).Seconds(); elapsed > 0 {
+			resp.UplinkRate = int64(float64(delta(up, s.lastUp)) / elapsed)
+			resp.DownlinkRate = int64(float64(delta(down, s.lastDown)) / elapsed)
+		}
+	}
+
+	s.lastAt = now
+	if reset {
+		s.lastUp, s.lastDown = 0, 0
+	} else {
+		s.lastUp, s.lastDown = up, down
+	}
+	return resp
+}
+
+// Reset forgets the previous sample, so the next one reports no rates.
+func (s *Sampler) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastUp, s.lastDown, s.lastAt = 0, 0, time.Time{}
+}
+
+// delta treats a total smaller than the previous one as a counter restart.
+func delta(cur, prev int64) int64 {
+	if cur < prev {
+		return cur
+	}
+	return cur - prev
+}
+
+// Meter counts the bytes passing through the connections it wraps.
+type Meter struct {
+	uplink   atomic.Int64
+	downlink atomic.Int64
+	sampler  Sampler
+}
+
+// AddUplink records n bytes sent to the remote side.
+func (m *Meter) AddUplink(n int) {
+	if n > 0 {
+		m.uplink.Add(int64(n))
+	}
+}
+
+// AddDownlink records n bytes received from the remote side.
+func (m *Meter) AddDownlink(n int) {
+	if n > 0 {
+		m.downlink.Add(int64(n))
+	}
+}
+
+// Reset zeroes the totals and the rates, for a core that starts afresh.
+func (m *Meter) Reset() {
+	m.uplink.Store(0)
+	m.downlink.Store(0)
+	m.sampler.Reset()
+}
+
+// Snapshot returns the totals and current rates, zeroing the totals if reset is set.
+func (m *Meter) Snapshot(reset bool) *proxycoreproto.TrafficStatsResponse {
+	if reset {
+		return m.sampler.Sample(m.uplink.Swap(0), m.downlink.Swap(0), true)
+	}
+	return m.sampler.Sample(m.uplink.Load(), m.downlink.Load(), false)
+}